@@ -4,6 +4,8 @@ go 1.14
 
 require (
 	github.com/atomix/go-client v0.4.1
+	github.com/containerd/containerd v1.4.4
+	github.com/deislabs/oras v0.11.1
 	github.com/dustinkirkland/golang-petname v0.0.0-20191129215211-8e5a1ed0cff0
 	github.com/fatih/color v1.7.0
 	github.com/gogo/protobuf v1.3.2
@@ -13,12 +15,14 @@ require (
 	github.com/onosproject/onos-lib-go v0.7.18
 	github.com/spf13/cobra v1.1.3
 	github.com/stretchr/testify v1.7.0
+	github.com/xeipuuv/gojsonschema v1.2.0
 	google.golang.org/grpc v1.33.2
 	gopkg.in/yaml.v2 v2.4.0
 	helm.sh/helm/v3 v3.6.1
 	k8s.io/api v0.21.0
 	k8s.io/apiextensions-apiserver v0.21.0
 	k8s.io/apimachinery v0.21.0
+	k8s.io/cli-runtime v0.21.0
 	k8s.io/client-go v0.21.0
 	rsc.io/letsencrypt v0.0.3 // indirect
 )
@@ -21,9 +21,11 @@ import (
 	"reflect"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// warmUpDuration is the duration for which benchmarks are warmed up if no warm up requests are configured
 const warmUpDuration = 30 * time.Second
 const aggBatchSize = 100
 
@@ -63,14 +65,29 @@ type TearDownBenchmark interface {
 	TearDownBenchmark(c *input.Context) error
 }
 
+// VerifyBenchmark is an interface for verifying the results of every benchmark
+type VerifyBenchmark interface {
+	VerifyBenchmark(c *input.Context) error
+}
+
 // newBenchmark creates a new benchmark
-func newBenchmark(requests int, duration *time.Duration, parallelism int, maxLatency *time.Duration, context *input.Context) *Benchmark {
+func newBenchmark(requests int, duration *time.Duration, parallelism int, maxLatency *time.Duration, window *time.Duration, histogramPrecision int, histogramMax time.Duration, percentiles []float32, rate float64, warmup int, context *input.Context) *Benchmark {
+	if len(percentiles) == 0 {
+		percentiles = DefaultPercentiles
+	}
 	return &Benchmark{
-		Context:     context,
-		requests:    requests,
-		duration:    duration,
-		maxLatency:  maxLatency,
-		parallelism: parallelism,
+		Context:            context,
+		requests:           requests,
+		duration:           duration,
+		maxLatency:         maxLatency,
+		window:             window,
+		parallelism:        parallelism,
+		histogramPrecision: histogramPrecision,
+		histogramMax:       histogramMax,
+		percentiles:        percentiles,
+		rate:               rate,
+		warmup:             warmup,
+		stopCh:             make(chan struct{}),
 	}
 }
 
@@ -82,6 +99,128 @@ type Benchmark struct {
 	duration    *time.Duration
 	parallelism int
 	maxLatency  *time.Duration
+	window      *time.Duration
+	stopCh      chan struct{}
+	stopOnce    sync.Once
+
+	// abortReason is the reason given by the benchmark code for aborting the benchmark
+	abortReason string
+	abortMu     sync.Mutex
+
+	// histogramPrecision and histogramMax are the significant decimal digits and maximum value
+	// of the histogram from which latency percentiles are computed
+	histogramPrecision int
+	histogramMax       time.Duration
+
+	// percentiles is the latency percentiles computed from the histogram
+	percentiles []float32
+
+	// rate is the target number of requests per second, or 0 to issue requests as fast as possible
+	rate float64
+
+	// warmup is the number of requests issued before requests are recorded, or 0 to warm up for warmUpDuration
+	warmup int
+
+	// runStart is the time in Unix nanoseconds at which the benchmark started recording requests, or 0 while
+	// the benchmark is warming up
+	runStart int64
+
+	// latencies is the histogram of the running benchmark, guarded by latenciesMu so running percentiles
+	// can be computed for progress requests
+	latencies   *histogram
+	latenciesMu sync.Mutex
+
+	// progressRequests and progressLatency are the number of requests and total latency in nanoseconds
+	// recorded since the last progress report
+	progressRequests uint64
+	progressLatency  int64
+
+	// totalRequests and totalLatency are the number of requests and total latency in nanoseconds
+	// recorded since the benchmark started
+	totalRequests uint64
+	totalLatency  int64
+
+	// totalErrors is the number of requests that returned an error since the benchmark started
+	totalErrors uint64
+}
+
+// stop signals the benchmark to stop issuing requests
+func (b *Benchmark) stop() {
+	b.stopOnce.Do(func() {
+		close(b.stopCh)
+	})
+}
+
+// Abort aborts the benchmark on all workers, failing the benchmark with the given reason
+// Abort is intended to be called by benchmark methods that detect an unrecoverable condition, e.g. a fatal error
+// returned by the service under test. Requests that are already running complete, but no further requests are
+// issued. Only the first reason given is reported.
+func (b *Benchmark) Abort(reason string) {
+	if reason == "" {
+		reason = "aborted by benchmark"
+	}
+	b.abortMu.Lock()
+	if b.abortReason == "" {
+		b.abortReason = reason
+	}
+	b.abortMu.Unlock()
+	b.stop()
+}
+
+// aborted returns the reason the benchmark was aborted, or an empty string if it was not aborted
+func (b *Benchmark) aborted() string {
+	b.abortMu.Lock()
+	defer b.abortMu.Unlock()
+	return b.abortReason
+}
+
+// isStopped returns whether the benchmark has been stopped
+func (b *Benchmark) isStopped() bool {
+	select {
+	case <-b.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// progress returns the number of requests and mean latency since the last call to progress
+func (b *Benchmark) progress() (int, time.Duration) {
+	requests := atomic.SwapUint64(&b.progressRequests, 0)
+	latency := atomic.SwapInt64(&b.progressLatency, 0)
+	if requests == 0 {
+		return 0, 0
+	}
+	return int(requests), time.Duration(latency / int64(requests))
+}
+
+// total returns the number of requests and mean latency since the benchmark started
+func (b *Benchmark) total() (int, time.Duration) {
+	requests := atomic.LoadUint64(&b.totalRequests)
+	latency := atomic.LoadInt64(&b.totalLatency)
+	if requests == 0 {
+		return 0, 0
+	}
+	return int(requests), time.Duration(latency / int64(requests))
+}
+
+// elapsed returns the time elapsed since the benchmark started recording requests, or 0 while warming up
+func (b *Benchmark) elapsed() time.Duration {
+	start := atomic.LoadInt64(&b.runStart)
+	if start == 0 {
+		return 0
+	}
+	return time.Since(time.Unix(0, start))
+}
+
+// percentile returns the latency at the given percentile of the requests completed since the benchmark started
+func (b *Benchmark) percentile(p float32) time.Duration {
+	b.latenciesMu.Lock()
+	defer b.latenciesMu.Unlock()
+	if b.latencies == nil {
+		return 0
+	}
+	return b.latencies.percentile(float64(p))
 }
 
 // Run runs the benchmark with the given parameters
@@ -106,29 +245,37 @@ func (b *Benchmark) run(suite BenchmarkingSuite) (*RunResponse, error) {
 	b.warmRequests(f)
 
 	// Run the benchmark
-	requests, runTime, results := b.runRequests(f)
-
-	// Calculate the total latency from latency results
-	var totalLatency time.Duration
-	for _, result := range results {
-		totalLatency += result
+	requests, runTime, latencies, stats, windows := b.runRequests(f)
+	if latencies.total == 0 {
+		return &RunResponse{
+			Requests:    uint32(requests),
+			Duration:    runTime,
+			Windows:     windows,
+			Errors:      uint32(atomic.LoadUint64(&b.totalErrors)),
+			AbortReason: b.aborted(),
+		}, nil
 	}
 
 	// Calculate latency percentiles
-	meanLatency := time.Duration(int64(totalLatency) / int64(len(results)))
-	latency50 := results[int(math.Max(float64(len(results)/2)-1, 0))]
-	latency75 := results[int(math.Max(float64(len(results)-(len(results)/4)-1), 0))]
-	latency95 := results[int(math.Max(float64(len(results)-(len(results)/20)-1), 0))]
-	latency99 := results[int(math.Max(float64(len(results)-(len(results)/100)-1), 0))]
-
+	percentiles := make([]PercentileLatency, 0, len(b.percentiles))
+	for _, percentile := range b.percentiles {
+		percentiles = append(percentiles, PercentileLatency{
+			Percentile: percentile,
+			Latency:    latencies.percentile(float64(percentile)),
+		})
+	}
 	return &RunResponse{
-		Requests:  uint32(requests),
-		Duration:  runTime,
-		Latency:   meanLatency,
-		Latency50: latency50,
-		Latency75: latency75,
-		Latency95: latency95,
-		Latency99: latency99,
+		Requests:           uint32(requests),
+		Duration:           runTime,
+		Latency:            stats.mean(),
+		LatencyPercentiles: percentiles,
+		MinLatency:         stats.min,
+		MaxLatency:         stats.max,
+		StdDevLatency:      stats.stdDev(),
+		LatencyHistogram:   latencies.buckets(),
+		Windows:            windows,
+		Errors:             uint32(atomic.LoadUint64(&b.totalErrors)),
+		AbortReason:        b.aborted(),
 	}, nil
 }
 
@@ -147,9 +294,10 @@ func (b *Benchmark) warmRequests(f func() error) {
 		}()
 	}
 
-	// Run for the warm up duration to prepare the benchmark
+	// Run the configured number of warm up requests, or for the warm up duration, to prepare the benchmark
+	pacer := newPacer(b.rate)
 	start := time.Now()
-	for time.Since(start) < warmUpDuration {
+	for requests := 0; (b.warmup > 0 && requests < b.warmup || b.warmup == 0 && time.Since(start) < warmUpDuration) && pacer.wait(b.stopCh); requests++ {
 		requestCh <- struct{}{}
 	}
 	close(requestCh)
@@ -158,70 +306,129 @@ func (b *Benchmark) warmRequests(f func() error) {
 	wg.Wait()
 }
 
+// sample is the latency of a single request and the time window in which it completed
+type sample struct {
+	latency time.Duration
+	window  int
+}
+
+// windowStats is the request metrics aggregated for a single time window
+type windowStats struct {
+	requests   int
+	total      time.Duration
+	maxLatency time.Duration
+}
+
+// latencyStats is the distribution of the latencies of all recorded requests
+type latencyStats struct {
+	count      int
+	total      time.Duration
+	min        time.Duration
+	max        time.Duration
+	sumSquares float64
+}
+
+// record records the given latency
+func (s *latencyStats) record(latency time.Duration) {
+	if s.count == 0 || latency < s.min {
+		s.min = latency
+	}
+	if latency > s.max {
+		s.max = latency
+	}
+	s.count++
+	s.total += latency
+	s.sumSquares += float64(latency) * float64(latency)
+}
+
+// mean returns the mean latency
+func (s *latencyStats) mean() time.Duration {
+	if s.count == 0 {
+		return 0
+	}
+	return s.total / time.Duration(s.count)
+}
+
+// stdDev returns the population standard deviation of the latencies
+func (s *latencyStats) stdDev() time.Duration {
+	if s.count == 0 {
+		return 0
+	}
+	mean := float64(s.total) / float64(s.count)
+	return time.Duration(math.Sqrt(math.Max(s.sumSquares/float64(s.count)-mean*mean, 0)))
+}
+
 // run runs the benchmark
-func (b *Benchmark) runRequests(f func() error) (int, time.Duration, []time.Duration) {
+func (b *Benchmark) runRequests(f func() error) (int, time.Duration, *histogram, *latencyStats, []Window) {
+	// Record the start time from which request windows are computed
+	runStart := time.Now()
+	atomic.StoreInt64(&b.runStart, runStart.UnixNano())
+
 	// Create an iteration channel and wait group and create a goroutine for each client
 	wg := &sync.WaitGroup{}
 	requestCh := make(chan struct{}, b.parallelism)
-	resultCh := make(chan time.Duration, aggBatchSize)
+	resultCh := make(chan sample, aggBatchSize)
 	for i := 0; i < b.parallelism; i++ {
 		wg.Add(1)
 		go func() {
 			for range requestCh {
 				start := time.Now()
-				_ = f()
+				if err := f(); err != nil {
+					atomic.AddUint64(&b.totalErrors, 1)
+				}
 				end := time.Now()
-				resultCh <- end.Sub(start)
+				latency := end.Sub(start)
+				atomic.AddUint64(&b.progressRequests, 1)
+				atomic.AddInt64(&b.progressLatency, int64(latency))
+				atomic.AddUint64(&b.totalRequests, 1)
+				atomic.AddInt64(&b.totalLatency, int64(latency))
+				window := 0
+				if b.window != nil && *b.window > 0 {
+					window = int(end.Sub(runStart) / *b.window)
+				}
+				resultCh <- sample{latency: latency, window: window}
 			}
 			wg.Done()
 		}()
 	}
 
 	// Start an aggregator goroutine
-	results := make([]time.Duration, 0, aggBatchSize*aggBatchSize)
+	latencies := newHistogram(b.histogramPrecision, b.histogramMax)
+	b.latenciesMu.Lock()
+	b.latencies = latencies
+	b.latenciesMu.Unlock()
+	windows := make(map[int]*windowStats)
+	stats := &latencyStats{}
 	aggWg := &sync.WaitGroup{}
 	aggWg.Add(1)
 	go func() {
-		var total time.Duration
-		var count = 0
-		// Iterate through results and aggregate durations
-		for duration := range resultCh {
-			total += duration
-			count++
-			// Average out the durations in batches
-			if count == aggBatchSize {
-				results = append(results, total/time.Duration(count))
-
-				// If the total number of batches reaches the batch size ^ 2, aggregate the aggregated results
-				if len(results) == aggBatchSize*aggBatchSize {
-					newResults := make([]time.Duration, 0, aggBatchSize*aggBatchSize)
-					for _, result := range results {
-						total += result
-						count++
-						if count == aggBatchSize {
-							newResults = append(newResults, total/time.Duration(count))
-							total = 0
-							count = 0
-						}
-					}
-					results = newResults
+		// Iterate through results and record durations
+		for sample := range resultCh {
+			duration := sample.latency
+			if b.window != nil && *b.window > 0 {
+				stats, ok := windows[sample.window]
+				if !ok {
+					stats = &windowStats{}
+					windows[sample.window] = stats
+				}
+				stats.requests++
+				stats.total += duration
+				if duration > stats.maxLatency {
+					stats.maxLatency = duration
 				}
-				total = 0
-				count = 0
 			}
-		}
-		if count > 0 {
-			results = append(results, total/time.Duration(count))
+			stats.record(duration)
+			b.latenciesMu.Lock()
+			latencies.record(duration)
+			b.latenciesMu.Unlock()
 		}
 		aggWg.Done()
 	}()
 
-	// Record the start time and write arguments to the channel
-	start := time.Now()
-
 	// Iterate through the request count or until the time duration has been met
+	pacer := newPacer(b.rate)
 	requests := 0
-	for (b.requests == 0 || requests < b.requests) && (b.duration == nil || time.Since(start) < *b.duration) {
+	for (b.requests == 0 || requests < b.requests) && (b.duration == nil || time.Since(runStart) < *b.duration) && pacer.wait(b.stopCh) {
 		requestCh <- struct{}{}
 		requests++
 	}
@@ -232,19 +439,73 @@ func (b *Benchmark) runRequests(f func() error) (int, time.Duration, []time.Dura
 
 	// Record the end time
 	end := time.Now()
-	duration := end.Sub(start)
+	duration := end.Sub(runStart)
 
 	// Close the output channel
 	close(resultCh)
 
 	// Wait for the results to be aggregated
 	aggWg.Wait()
+	return requests, duration, latencies, stats, getWindows(windows)
+}
 
-	// Sort the aggregated results
-	sort.Slice(results, func(i, j int) bool {
-		return results[i] < results[j]
+// pacer paces requests to a target rate
+type pacer struct {
+	interval time.Duration
+	next     time.Time
+}
+
+// newPacer returns a pacer for the given number of requests per second, or an unpaced pacer if the rate is 0
+func newPacer(rate float64) *pacer {
+	var interval time.Duration
+	if rate > 0 {
+		interval = time.Duration(float64(time.Second) / rate)
+	}
+	return &pacer{interval: interval}
+}
+
+// wait waits until the next request is due and returns false if the benchmark was stopped while waiting
+// Requests are scheduled at a fixed interval from the previous request. If requests fall behind because
+// all clients are busy, the schedule is reset rather than issuing a burst of requests to catch up.
+func (p *pacer) wait(stopCh <-chan struct{}) bool {
+	if p.interval > 0 {
+		now := time.Now()
+		if p.next.After(now) {
+			timer := time.NewTimer(p.next.Sub(now))
+			select {
+			case <-timer.C:
+			case <-stopCh:
+				timer.Stop()
+				return false
+			}
+		} else {
+			p.next = now
+		}
+		p.next = p.next.Add(p.interval)
+	}
+	select {
+	case <-stopCh:
+		return false
+	default:
+		return true
+	}
+}
+
+// getWindows returns the given window statistics as a series ordered by window index
+func getWindows(stats map[int]*windowStats) []Window {
+	windows := make([]Window, 0, len(stats))
+	for index, window := range stats {
+		windows = append(windows, Window{
+			Index:      uint32(index),
+			Requests:   uint32(window.requests),
+			Latency:    window.total / time.Duration(window.requests),
+			MaxLatency: window.maxLatency,
+		})
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Index < windows[j].Index
 	})
-	return requests, duration, results
+	return windows
 }
 
 // getBenchmarks returns a list of benchmarks in the given suite
@@ -5,6 +5,7 @@ package benchmark
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -37,6 +38,10 @@ type SuiteRequest struct {
 	Suite string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
 	// args is the benchmark arguments
 	Args map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// worker is the index of the worker receiving the request
+	Worker uint32 `protobuf:"varint,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	// workers is the total number of workers
+	Workers uint32 `protobuf:"varint,4,opt,name=workers,proto3" json:"workers,omitempty"`
 }
 
 func (m *SuiteRequest) Reset()         { *m = SuiteRequest{} }
@@ -86,6 +91,20 @@ func (m *SuiteRequest) GetArgs() map[string]string {
 	return nil
 }
 
+func (m *SuiteRequest) GetWorker() uint32 {
+	if m != nil {
+		return m.Worker
+	}
+	return 0
+}
+
+func (m *SuiteRequest) GetWorkers() uint32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
 // SuiteResponse is a response to a SuiteRequest
 type SuiteResponse struct {
 }
@@ -131,6 +150,10 @@ type BenchmarkRequest struct {
 	Benchmark string `protobuf:"bytes,2,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
 	// args is the benchmark arguments
 	Args map[string]string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// worker is the index of the worker receiving the request
+	Worker uint32 `protobuf:"varint,4,opt,name=worker,proto3" json:"worker,omitempty"`
+	// workers is the total number of workers
+	Workers uint32 `protobuf:"varint,5,opt,name=workers,proto3" json:"workers,omitempty"`
 }
 
 func (m *BenchmarkRequest) Reset()         { *m = BenchmarkRequest{} }
@@ -187,6 +210,20 @@ func (m *BenchmarkRequest) GetArgs() map[string]string {
 	return nil
 }
 
+func (m *BenchmarkRequest) GetWorker() uint32 {
+	if m != nil {
+		return m.Worker
+	}
+	return 0
+}
+
+func (m *BenchmarkRequest) GetWorkers() uint32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
 // BenchmarkResponse is a benchmark response
 type BenchmarkResponse struct {
 }
@@ -240,6 +277,22 @@ type RunRequest struct {
 	Args map[string]string `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// maximum allowed latency before the benchmark will fail
 	MaxLatency *time.Duration `protobuf:"bytes,7,opt,name=maxLatency,proto3,stdduration" json:"maxLatency,omitempty"`
+	// window is the duration of the time windows into which request metrics are aggregated
+	Window *time.Duration `protobuf:"bytes,8,opt,name=window,proto3,stdduration" json:"window,omitempty"`
+	// worker is the index of the worker receiving the request
+	Worker uint32 `protobuf:"varint,9,opt,name=worker,proto3" json:"worker,omitempty"`
+	// workers is the total number of workers
+	Workers uint32 `protobuf:"varint,10,opt,name=workers,proto3" json:"workers,omitempty"`
+	// histogram_precision is the number of significant decimal digits with which latencies are recorded
+	HistogramPrecision uint32 `protobuf:"varint,11,opt,name=histogram_precision,json=histogramPrecision,proto3" json:"histogram_precision,omitempty"`
+	// histogram_max is the maximum latency recorded by the latency histogram
+	HistogramMax *time.Duration `protobuf:"bytes,12,opt,name=histogram_max,json=histogramMax,proto3,stdduration" json:"histogram_max,omitempty"`
+	// percentiles is the latency percentiles to compute, each between 0 and 1
+	Percentiles []float32 `protobuf:"fixed32,13,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+	// rate is the target number of requests per second issued by the worker, or 0 to issue requests as fast as possible
+	Rate float64 `protobuf:"fixed64,14,opt,name=rate,proto3" json:"rate,omitempty"`
+	// warmup is the number of requests to issue before requests are recorded, or 0 to warm up for a fixed duration
+	Warmup uint32 `protobuf:"varint,15,opt,name=warmup,proto3" json:"warmup,omitempty"`
 }
 
 func (m *RunRequest) Reset()         { *m = RunRequest{} }
@@ -324,6 +377,62 @@ func (m *RunRequest) GetMaxLatency() *time.Duration {
 	return nil
 }
 
+func (m *RunRequest) GetWindow() *time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *RunRequest) GetWorker() uint32 {
+	if m != nil {
+		return m.Worker
+	}
+	return 0
+}
+
+func (m *RunRequest) GetWorkers() uint32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *RunRequest) GetHistogramPrecision() uint32 {
+	if m != nil {
+		return m.HistogramPrecision
+	}
+	return 0
+}
+
+func (m *RunRequest) GetHistogramMax() *time.Duration {
+	if m != nil {
+		return m.HistogramMax
+	}
+	return nil
+}
+
+func (m *RunRequest) GetPercentiles() []float32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+func (m *RunRequest) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RunRequest) GetWarmup() uint32 {
+	if m != nil {
+		return m.Warmup
+	}
+	return 0
+}
+
 // RunResponse is a benchmark run response
 type RunResponse struct {
 	// suite is the benchmark suite
@@ -336,11 +445,23 @@ type RunResponse struct {
 	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
 	// latency is the mean latency
 	Latency time.Duration `protobuf:"bytes,5,opt,name=latency,proto3,stdduration" json:"latency"`
-	// latency* are latency percentiles
-	Latency50 time.Duration `protobuf:"bytes,6,opt,name=latency50,proto3,stdduration" json:"latency50"`
-	Latency75 time.Duration `protobuf:"bytes,7,opt,name=latency75,proto3,stdduration" json:"latency75"`
-	Latency95 time.Duration `protobuf:"bytes,8,opt,name=latency95,proto3,stdduration" json:"latency95"`
-	Latency99 time.Duration `protobuf:"bytes,9,opt,name=latency99,proto3,stdduration" json:"latency99"`
+	// windows is the series of time windows into which request metrics were aggregated
+	Windows []Window `protobuf:"bytes,10,rep,name=windows,proto3" json:"windows"`
+	// errors is the number of requests that returned an error
+	Errors uint32 `protobuf:"varint,11,opt,name=errors,proto3" json:"errors,omitempty"`
+	// abort_reason is the reason the benchmark was aborted by the benchmark code, if it was aborted
+	AbortReason string `protobuf:"bytes,12,opt,name=abort_reason,json=abortReason,proto3" json:"abort_reason,omitempty"`
+	// latency_percentiles are the latency percentiles requested by the run request, in ascending order
+	LatencyPercentiles []PercentileLatency `protobuf:"bytes,13,rep,name=latency_percentiles,json=latencyPercentiles,proto3" json:"latency_percentiles"`
+	// min_latency is the minimum latency
+	MinLatency time.Duration `protobuf:"bytes,14,opt,name=min_latency,json=minLatency,proto3,stdduration" json:"min_latency"`
+	// max_latency is the maximum latency
+	MaxLatency time.Duration `protobuf:"bytes,15,opt,name=max_latency,json=maxLatency,proto3,stdduration" json:"max_latency"`
+	// std_dev_latency is the standard deviation of the latency
+	StdDevLatency time.Duration `protobuf:"bytes,16,opt,name=std_dev_latency,json=stdDevLatency,proto3,stdduration" json:"std_dev_latency"`
+	// latency_histogram is the non-empty buckets of the latency histogram, from which the coordinator computes
+	// the percentiles of the requests of all workers
+	LatencyHistogram []HistogramBucket `protobuf:"bytes,17,rep,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram"`
 }
 
 func (m *RunResponse) Reset()         { *m = RunResponse{} }
@@ -411,865 +532,3982 @@ func (m *RunResponse) GetLatency() time.Duration {
 	return 0
 }
 
-func (m *RunResponse) GetLatency50() time.Duration {
+func (m *RunResponse) GetWindows() []Window {
 	if m != nil {
-		return m.Latency50
+		return m.Windows
 	}
-	return 0
+	return nil
 }
 
-func (m *RunResponse) GetLatency75() time.Duration {
+func (m *RunResponse) GetErrors() uint32 {
 	if m != nil {
-		return m.Latency75
+		return m.Errors
 	}
 	return 0
 }
 
-func (m *RunResponse) GetLatency95() time.Duration {
+func (m *RunResponse) GetAbortReason() string {
 	if m != nil {
-		return m.Latency95
+		return m.AbortReason
 	}
-	return 0
+	return ""
 }
 
-func (m *RunResponse) GetLatency99() time.Duration {
+func (m *RunResponse) GetLatencyPercentiles() []PercentileLatency {
 	if m != nil {
-		return m.Latency99
+		return m.LatencyPercentiles
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*SuiteRequest)(nil), "onos.test.benchmark.SuiteRequest")
-	proto.RegisterMapType((map[string]string)(nil), "onos.test.benchmark.SuiteRequest.ArgsEntry")
-	proto.RegisterType((*SuiteResponse)(nil), "onos.test.benchmark.SuiteResponse")
-	proto.RegisterType((*BenchmarkRequest)(nil), "onos.test.benchmark.BenchmarkRequest")
-	proto.RegisterMapType((map[string]string)(nil), "onos.test.benchmark.BenchmarkRequest.ArgsEntry")
-	proto.RegisterType((*BenchmarkResponse)(nil), "onos.test.benchmark.BenchmarkResponse")
-	proto.RegisterType((*RunRequest)(nil), "onos.test.benchmark.RunRequest")
-	proto.RegisterMapType((map[string]string)(nil), "onos.test.benchmark.RunRequest.ArgsEntry")
-	proto.RegisterType((*RunResponse)(nil), "onos.test.benchmark.RunResponse")
+func (m *RunResponse) GetMinLatency() time.Duration {
+	if m != nil {
+		return m.MinLatency
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("benchmark/benchmark.proto", fileDescriptor_31dca67ba579dd0a) }
-
-var fileDescriptor_31dca67ba579dd0a = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6f, 0x12, 0x41,
-	0x14, 0xc7, 0x19, 0x7e, 0x15, 0x1e, 0xa5, 0xb6, 0xd3, 0x1e, 0xa6, 0x1b, 0xb3, 0x20, 0x89, 0x06,
-	0x63, 0xb2, 0x18, 0x0c, 0x41, 0x34, 0x0d, 0x29, 0xd6, 0x9b, 0x17, 0x97, 0xc6, 0x1e, 0xcd, 0x82,
-	0xe3, 0x4a, 0x58, 0x76, 0x70, 0x76, 0xb7, 0xca, 0xd9, 0x7f, 0xc0, 0xa3, 0x27, 0xff, 0x1a, 0x0f,
-	0x3d, 0xf6, 0xe8, 0x49, 0x0d, 0x9c, 0xbc, 0xfb, 0x07, 0x18, 0x66, 0x67, 0x97, 0x15, 0xb1, 0xd0,
-	0x4a, 0x6f, 0xf3, 0xe3, 0xbd, 0xcf, 0xfb, 0x7e, 0xdf, 0x4c, 0x1e, 0xec, 0x77, 0xa8, 0xdd, 0x7d,
-	0x33, 0x30, 0x78, 0xbf, 0x12, 0xae, 0xb4, 0x21, 0x67, 0x2e, 0xc3, 0xbb, 0xcc, 0x66, 0x8e, 0xe6,
-	0x52, 0xc7, 0xd5, 0xc2, 0x2b, 0x65, 0xcf, 0x64, 0x26, 0x13, 0xf7, 0x95, 0xe9, 0xca, 0x0f, 0x55,
-	0x54, 0x93, 0x31, 0xd3, 0xa2, 0x15, 0xb1, 0xeb, 0x78, 0xaf, 0x2b, 0xaf, 0x3c, 0x6e, 0xb8, 0x3d,
-	0x66, 0xfb, 0xf7, 0xa5, 0xcf, 0x08, 0x36, 0xdb, 0x5e, 0xcf, 0xa5, 0x3a, 0x7d, 0xeb, 0x51, 0xc7,
-	0xc5, 0x7b, 0x90, 0x72, 0xa6, 0x7b, 0x82, 0x8a, 0xa8, 0x9c, 0xd5, 0xfd, 0x0d, 0x6e, 0x42, 0xd2,
-	0xe0, 0xa6, 0x43, 0xe2, 0xc5, 0x44, 0x39, 0x57, 0xbd, 0xa7, 0x2d, 0x10, 0xa0, 0x45, 0x31, 0xda,
-	0x21, 0x37, 0x9d, 0xa7, 0xb6, 0xcb, 0x47, 0xba, 0x48, 0x54, 0xea, 0x90, 0x0d, 0x8f, 0xf0, 0x36,
-	0x24, 0xfa, 0x74, 0x24, 0x2b, 0x4c, 0x97, 0xd3, 0xaa, 0xa7, 0x86, 0xe5, 0x51, 0x12, 0xf7, 0xab,
-	0x8a, 0xcd, 0xa3, 0xf8, 0x43, 0x54, 0xba, 0x01, 0x79, 0x09, 0x76, 0x86, 0xcc, 0x76, 0x68, 0xe9,
-	0x0b, 0x82, 0xed, 0x56, 0x50, 0xf4, 0x62, 0xd5, 0x37, 0x21, 0x1b, 0xca, 0x93, 0xe4, 0xd9, 0x01,
-	0x7e, 0x22, 0x3d, 0x25, 0x84, 0xa7, 0xca, 0x42, 0x4f, 0xf3, 0x85, 0xd6, 0xe7, 0x6b, 0x17, 0x76,
-	0x22, 0x70, 0xe9, 0xed, 0x57, 0x1c, 0x40, 0xf7, 0xec, 0xff, 0x71, 0xa5, 0x40, 0x86, 0xfb, 0xe9,
-	0x53, 0x67, 0xa8, 0x9c, 0xd7, 0xc3, 0x3d, 0x7e, 0x0c, 0x99, 0xe0, 0xf9, 0x49, 0xb2, 0x88, 0xca,
-	0xb9, 0xea, 0xbe, 0xe6, 0xff, 0x0f, 0x2d, 0xf8, 0x1f, 0xda, 0x91, 0x0c, 0x68, 0x25, 0x3f, 0x7d,
-	0x2f, 0x20, 0x3d, 0x4c, 0xc0, 0x45, 0xc8, 0x0d, 0x0d, 0x6e, 0x58, 0x16, 0xb5, 0x7a, 0xce, 0x80,
-	0xa4, 0x04, 0x3b, 0x7a, 0x84, 0x0f, 0x64, 0x43, 0xd3, 0xa2, 0xa1, 0x77, 0x17, 0x36, 0x74, 0xe6,
-	0x6e, 0xbe, 0x95, 0xb8, 0x09, 0x30, 0x30, 0xde, 0x3f, 0x33, 0x5c, 0x6a, 0x77, 0x47, 0x64, 0x63,
-	0x35, 0x7d, 0x91, 0x94, 0xab, 0xbf, 0xc5, 0xcf, 0x04, 0xe4, 0x84, 0x30, 0xff, 0x19, 0xd6, 0xde,
-	0xf7, 0xe6, 0x65, 0xfa, 0x9e, 0x39, 0xfb, 0x56, 0x88, 0xcd, 0xf5, 0xfe, 0x00, 0x36, 0x2c, 0xd9,
-	0x97, 0xd4, 0xea, 0xf9, 0x41, 0x0e, 0x3e, 0x84, 0xac, 0x5c, 0xd6, 0xee, 0x93, 0xf4, 0xea, 0x80,
-	0x59, 0x56, 0x04, 0x51, 0xaf, 0x2d, 0x7f, 0x9b, 0xbf, 0x11, 0xf5, 0x5a, 0x04, 0xd1, 0xa8, 0x91,
-	0xcc, 0xe5, 0x11, 0x8d, 0x3f, 0x10, 0x0d, 0x92, 0xbd, 0x02, 0xa2, 0x51, 0xfd, 0x90, 0x82, 0xfc,
-	0x09, 0xe3, 0x7d, 0xca, 0xdb, 0x94, 0x9f, 0xf6, 0xba, 0x14, 0xb7, 0x01, 0xda, 0xd4, 0xf5, 0x86,
-	0x62, 0xcc, 0xe0, 0x5b, 0x4b, 0x67, 0x9b, 0x52, 0xba, 0x28, 0x44, 0x7e, 0xa1, 0x17, 0x90, 0x3f,
-	0xa6, 0x06, 0x3f, 0x62, 0xef, 0xec, 0xb5, 0x72, 0x8f, 0x21, 0x27, 0xc4, 0xfa, 0x16, 0xd6, 0x45,
-	0x3d, 0x81, 0xad, 0x40, 0xed, 0x7a, 0xc1, 0x2f, 0x61, 0x4b, 0xc8, 0x0d, 0x47, 0x1d, 0xbe, 0xbd,
-	0xd2, 0x9c, 0x55, 0xee, 0x2c, 0x0b, 0x93, 0x05, 0x3a, 0xb0, 0x13, 0x28, 0xbf, 0xb6, 0x1a, 0xcf,
-	0x61, 0x53, 0xf7, 0x22, 0xf8, 0xc2, 0x92, 0xc9, 0xa6, 0x14, 0xff, 0x1d, 0xe0, 0x23, 0x5b, 0xe4,
-	0x6c, 0xac, 0xa2, 0xf3, 0xb1, 0x8a, 0x7e, 0x8c, 0x55, 0xf4, 0x71, 0xa2, 0xc6, 0xce, 0x27, 0x6a,
-	0xec, 0xeb, 0x44, 0x8d, 0x75, 0xd2, 0xe2, 0x1f, 0x3f, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x55,
-	0xc1, 0x30, 0x17, 0xff, 0x07, 0x00, 0x00,
+func (m *RunResponse) GetMaxLatency() time.Duration {
+	if m != nil {
+		return m.MaxLatency
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// WorkerServiceClient is the client API for WorkerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WorkerServiceClient interface {
-	SetupSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
-	TearDownSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
-	SetupWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
-	TearDownWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
-	SetupBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
-	TearDownBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
-	RunBenchmark(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error)
+func (m *RunResponse) GetStdDevLatency() time.Duration {
+	if m != nil {
+		return m.StdDevLatency
+	}
+	return 0
 }
 
-type workerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *RunResponse) GetLatencyHistogram() []HistogramBucket {
+	if m != nil {
+		return m.LatencyHistogram
+	}
+	return nil
 }
 
-func NewWorkerServiceClient(cc *grpc.ClientConn) WorkerServiceClient {
-	return &workerServiceClient{cc}
+// HistogramBucket is a bucket of a latency histogram
+type HistogramBucket struct {
+	// latency is the highest latency recorded in the bucket
+	Latency time.Duration `protobuf:"bytes,1,opt,name=latency,proto3,stdduration" json:"latency"`
+	// count is the number of latencies recorded in the bucket
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (c *workerServiceClient) SetupSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
-	out := new(SuiteResponse)
-	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/SetupSuite", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HistogramBucket) Reset()         { *m = HistogramBucket{} }
+func (m *HistogramBucket) String() string { return proto.CompactTextString(m) }
+func (*HistogramBucket) ProtoMessage()    {}
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{6}
 }
-
-func (c *workerServiceClient) TearDownSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
-	out := new(SuiteResponse)
-	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/TearDownSuite", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HistogramBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistogramBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistogramBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *HistogramBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistogramBucket.Merge(m, src)
+}
+func (m *HistogramBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistogramBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistogramBucket.DiscardUnknown(m)
 }
 
-func (c *workerServiceClient) SetupWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
-	out := new(SuiteResponse)
-	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/SetupWorker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HistogramBucket proto.InternalMessageInfo
+
+func (m *HistogramBucket) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerServiceClient) TearDownWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
-	out := new(SuiteResponse)
-	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/TearDownWorker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HistogramBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerServiceClient) SetupBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
-	out := new(BenchmarkResponse)
-	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/SetupBenchmark", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// PercentileLatency is the latency at a single percentile
+type PercentileLatency struct {
+	// percentile is the percentile between 0 and 1
+	Percentile float32 `protobuf:"fixed32,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// latency is the latency at the percentile
+	Latency time.Duration `protobuf:"bytes,2,opt,name=latency,proto3,stdduration" json:"latency"`
 }
 
-func (c *workerServiceClient) TearDownBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
-	out := new(BenchmarkResponse)
-	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/TearDownBenchmark", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PercentileLatency) Reset()         { *m = PercentileLatency{} }
+func (m *PercentileLatency) String() string { return proto.CompactTextString(m) }
+func (*PercentileLatency) ProtoMessage()    {}
+func (*PercentileLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{7}
+}
+func (m *PercentileLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentileLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentileLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PercentileLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentileLatency.Merge(m, src)
+}
+func (m *PercentileLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentileLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentileLatency.DiscardUnknown(m)
 }
 
-func (c *workerServiceClient) RunBenchmark(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error) {
-	out := new(RunResponse)
-	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/RunBenchmark", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PercentileLatency proto.InternalMessageInfo
+
+func (m *PercentileLatency) GetPercentile() float32 {
+	if m != nil {
+		return m.Percentile
 	}
-	return out, nil
+	return 0
 }
 
-// WorkerServiceServer is the server API for WorkerService service.
-type WorkerServiceServer interface {
-	SetupSuite(context.Context, *SuiteRequest) (*SuiteResponse, error)
-	TearDownSuite(context.Context, *SuiteRequest) (*SuiteResponse, error)
-	SetupWorker(context.Context, *SuiteRequest) (*SuiteResponse, error)
-	TearDownWorker(context.Context, *SuiteRequest) (*SuiteResponse, error)
-	SetupBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResponse, error)
-	TearDownBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResponse, error)
-	RunBenchmark(context.Context, *RunRequest) (*RunResponse, error)
+func (m *PercentileLatency) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
 }
 
-// UnimplementedWorkerServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkerServiceServer struct {
+// Window is the request metrics for a time window of a benchmark run
+type Window struct {
+	// index is the index of the window from the start of the run
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// requests is the number of requests completed within the window
+	Requests uint32 `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	// latency is the mean latency of requests completed within the window
+	Latency time.Duration `protobuf:"bytes,3,opt,name=latency,proto3,stdduration" json:"latency"`
+	// max_latency is the maximum latency of requests completed within the window
+	MaxLatency time.Duration `protobuf:"bytes,4,opt,name=max_latency,json=maxLatency,proto3,stdduration" json:"max_latency"`
 }
 
-func (*UnimplementedWorkerServiceServer) SetupSuite(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetupSuite not implemented")
+func (m *Window) Reset()         { *m = Window{} }
+func (m *Window) String() string { return proto.CompactTextString(m) }
+func (*Window) ProtoMessage()    {}
+func (*Window) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{8}
 }
-func (*UnimplementedWorkerServiceServer) TearDownSuite(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TearDownSuite not implemented")
+func (m *Window) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedWorkerServiceServer) SetupWorker(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetupWorker not implemented")
+func (m *Window) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Window.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedWorkerServiceServer) TearDownWorker(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TearDownWorker not implemented")
+func (m *Window) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Window.Merge(m, src)
 }
-func (*UnimplementedWorkerServiceServer) SetupBenchmark(ctx context.Context, req *BenchmarkRequest) (*BenchmarkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetupBenchmark not implemented")
+func (m *Window) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedWorkerServiceServer) TearDownBenchmark(ctx context.Context, req *BenchmarkRequest) (*BenchmarkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TearDownBenchmark not implemented")
+func (m *Window) XXX_DiscardUnknown() {
+	xxx_messageInfo_Window.DiscardUnknown(m)
 }
-func (*UnimplementedWorkerServiceServer) RunBenchmark(ctx context.Context, req *RunRequest) (*RunResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunBenchmark not implemented")
+
+var xxx_messageInfo_Window proto.InternalMessageInfo
+
+func (m *Window) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func RegisterWorkerServiceServer(s *grpc.Server, srv WorkerServiceServer) {
-	s.RegisterService(&_WorkerService_serviceDesc, srv)
+func (m *Window) GetRequests() uint32 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
 }
 
-func _WorkerService_SetupSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuiteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Window) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
 	}
-	if interceptor == nil {
-		return srv.(WorkerServiceServer).SetupSuite(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onos.test.benchmark.WorkerService/SetupSuite",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServiceServer).SetupSuite(ctx, req.(*SuiteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WorkerService_TearDownSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuiteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServiceServer).TearDownSuite(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onos.test.benchmark.WorkerService/TearDownSuite",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServiceServer).TearDownSuite(ctx, req.(*SuiteRequest))
+func (m *Window) GetMaxLatency() time.Duration {
+	if m != nil {
+		return m.MaxLatency
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WorkerService_SetupWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuiteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServiceServer).SetupWorker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onos.test.benchmark.WorkerService/SetupWorker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServiceServer).SetupWorker(ctx, req.(*SuiteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ProgressRequest is a request for the progress of a running benchmark
+type ProgressRequest struct {
+	// suite is the benchmark suite
+	Suite string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	// benchmark is the running benchmark
+	Benchmark string `protobuf:"bytes,2,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	// total indicates whether to return the progress since the benchmark started rather than since the
+	// previous progress request
+	Total bool `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// percentile is a latency percentile to compute over all requests completed since the benchmark started,
+	// or 0 to compute no percentile
+	Percentile float32 `protobuf:"fixed32,4,opt,name=percentile,proto3" json:"percentile,omitempty"`
 }
 
-func _WorkerService_TearDownWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuiteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServiceServer).TearDownWorker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onos.test.benchmark.WorkerService/TearDownWorker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServiceServer).TearDownWorker(ctx, req.(*SuiteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ProgressRequest) Reset()         { *m = ProgressRequest{} }
+func (m *ProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*ProgressRequest) ProtoMessage()    {}
+func (*ProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{9}
 }
-
-func _WorkerService_SetupBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BenchmarkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServiceServer).SetupBenchmark(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onos.test.benchmark.WorkerService/SetupBenchmark",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServiceServer).SetupBenchmark(ctx, req.(*BenchmarkRequest))
+func (m *ProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgressRequest.Merge(m, src)
+}
+func (m *ProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgressRequest.DiscardUnknown(m)
 }
 
-func _WorkerService_TearDownBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BenchmarkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServiceServer).TearDownBenchmark(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onos.test.benchmark.WorkerService/TearDownBenchmark",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServiceServer).TearDownBenchmark(ctx, req.(*BenchmarkRequest))
+var xxx_messageInfo_ProgressRequest proto.InternalMessageInfo
+
+func (m *ProgressRequest) GetSuite() string {
+	if m != nil {
+		return m.Suite
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkerService_RunBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RunRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServiceServer).RunBenchmark(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onos.test.benchmark.WorkerService/RunBenchmark",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServiceServer).RunBenchmark(ctx, req.(*RunRequest))
+func (m *ProgressRequest) GetBenchmark() string {
+	if m != nil {
+		return m.Benchmark
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _WorkerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "onos.test.benchmark.WorkerService",
-	HandlerType: (*WorkerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetupSuite",
-			Handler:    _WorkerService_SetupSuite_Handler,
-		},
-		{
-			MethodName: "TearDownSuite",
-			Handler:    _WorkerService_TearDownSuite_Handler,
-		},
-		{
-			MethodName: "SetupWorker",
-			Handler:    _WorkerService_SetupWorker_Handler,
-		},
-		{
-			MethodName: "TearDownWorker",
-			Handler:    _WorkerService_TearDownWorker_Handler,
-		},
-		{
-			MethodName: "SetupBenchmark",
-			Handler:    _WorkerService_SetupBenchmark_Handler,
-		},
-		{
-			MethodName: "TearDownBenchmark",
-			Handler:    _WorkerService_TearDownBenchmark_Handler,
-		},
-		{
-			MethodName: "RunBenchmark",
-			Handler:    _WorkerService_RunBenchmark_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "benchmark/benchmark.proto",
+func (m *ProgressRequest) GetTotal() bool {
+	if m != nil {
+		return m.Total
+	}
+	return false
 }
 
-func (m *SuiteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProgressRequest) GetPercentile() float32 {
+	if m != nil {
+		return m.Percentile
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SuiteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProgressResponse is a running benchmark's progress since the previous ProgressRequest
+type ProgressResponse struct {
+	// requests is the number of requests completed since the previous progress request
+	Requests uint32 `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	// latency is the mean latency of requests completed since the previous progress request
+	Latency time.Duration `protobuf:"bytes,2,opt,name=latency,proto3,stdduration" json:"latency"`
+	// percentile_latency is the latency at the requested percentile
+	PercentileLatency time.Duration `protobuf:"bytes,3,opt,name=percentile_latency,json=percentileLatency,proto3,stdduration" json:"percentile_latency"`
+	// duration is the time elapsed since the benchmark started recording requests, or 0 while warming up
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
 }
 
-func (m *SuiteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for k := range m.Args {
-			v := m.Args[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintBenchmark(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintBenchmark(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintBenchmark(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+func (m *ProgressResponse) Reset()         { *m = ProgressResponse{} }
+func (m *ProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*ProgressResponse) ProtoMessage()    {}
+func (*ProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{10}
+}
+func (m *ProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Suite) > 0 {
-		i -= len(m.Suite)
-		copy(dAtA[i:], m.Suite)
-		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgressResponse.Merge(m, src)
+}
+func (m *ProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProgressResponse proto.InternalMessageInfo
+
+func (m *ProgressResponse) GetRequests() uint32 {
+	if m != nil {
+		return m.Requests
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SuiteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProgressResponse) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SuiteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProgressResponse) GetPercentileLatency() time.Duration {
+	if m != nil {
+		return m.PercentileLatency
+	}
+	return 0
 }
 
-func (m *SuiteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ProgressResponse) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
 
-func (m *BenchmarkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// StopRequest is a request to stop a running benchmark
+type StopRequest struct {
+	// suite is the benchmark suite
+	Suite string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	// benchmark is the benchmark to stop
+	Benchmark string `protobuf:"bytes,2,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
 }
 
-func (m *BenchmarkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StopRequest) Reset()         { *m = StopRequest{} }
+func (m *StopRequest) String() string { return proto.CompactTextString(m) }
+func (*StopRequest) ProtoMessage()    {}
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{11}
 }
-
-func (m *BenchmarkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for k := range m.Args {
-			v := m.Args[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintBenchmark(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintBenchmark(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintBenchmark(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+func (m *StopRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Benchmark) > 0 {
-		i -= len(m.Benchmark)
-		copy(dAtA[i:], m.Benchmark)
-		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Suite) > 0 {
-		i -= len(m.Suite)
-		copy(dAtA[i:], m.Suite)
-		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *StopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopRequest.Merge(m, src)
+}
+func (m *StopRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopRequest.DiscardUnknown(m)
 }
 
-func (m *BenchmarkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StopRequest proto.InternalMessageInfo
+
+func (m *StopRequest) GetSuite() string {
+	if m != nil {
+		return m.Suite
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BenchmarkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StopRequest) GetBenchmark() string {
+	if m != nil {
+		return m.Benchmark
+	}
+	return ""
 }
 
-func (m *BenchmarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// StopResponse is a response to a StopRequest
+type StopResponse struct {
 }
 
-func (m *RunRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StopResponse) Reset()         { *m = StopResponse{} }
+func (m *StopResponse) String() string { return proto.CompactTextString(m) }
+func (*StopResponse) ProtoMessage()    {}
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{12}
+}
+func (m *StopResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopResponse.Merge(m, src)
+}
+func (m *StopResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopResponse.DiscardUnknown(m)
 }
 
-func (m *RunRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StopResponse proto.InternalMessageInfo
+
+// ShutdownRequest is a request to gracefully shut down a worker
+type ShutdownRequest struct {
+	// suite is the benchmark suite
+	Suite string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	// args is the benchmark arguments
+	Args map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// tear_down_suite indicates whether the worker should tear down the suite before shutting down
+	TearDownSuite bool `protobuf:"varint,3,opt,name=tear_down_suite,json=tearDownSuite,proto3" json:"tear_down_suite,omitempty"`
+	// worker is the index of the worker receiving the request
+	Worker uint32 `protobuf:"varint,4,opt,name=worker,proto3" json:"worker,omitempty"`
+	// workers is the total number of workers
+	Workers uint32 `protobuf:"varint,5,opt,name=workers,proto3" json:"workers,omitempty"`
 }
 
-func (m *RunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxLatency != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxLatency):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintBenchmark(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Args) > 0 {
-		for k := range m.Args {
-			v := m.Args[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintBenchmark(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintBenchmark(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintBenchmark(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
+func (m *ShutdownRequest) Reset()         { *m = ShutdownRequest{} }
+func (m *ShutdownRequest) String() string { return proto.CompactTextString(m) }
+func (*ShutdownRequest) ProtoMessage()    {}
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{13}
+}
+func (m *ShutdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShutdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShutdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Parallelism != 0 {
-		i = encodeVarintBenchmark(dAtA, i, uint64(m.Parallelism))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Duration != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintBenchmark(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *ShutdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShutdownRequest.Merge(m, src)
+}
+func (m *ShutdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShutdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShutdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShutdownRequest proto.InternalMessageInfo
+
+func (m *ShutdownRequest) GetSuite() string {
+	if m != nil {
+		return m.Suite
 	}
-	if m.Requests != 0 {
-		i = encodeVarintBenchmark(dAtA, i, uint64(m.Requests))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *ShutdownRequest) GetArgs() map[string]string {
+	if m != nil {
+		return m.Args
 	}
-	if len(m.Benchmark) > 0 {
-		i -= len(m.Benchmark)
-		copy(dAtA[i:], m.Benchmark)
-		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ShutdownRequest) GetTearDownSuite() bool {
+	if m != nil {
+		return m.TearDownSuite
 	}
-	if len(m.Suite) > 0 {
-		i -= len(m.Suite)
-		copy(dAtA[i:], m.Suite)
-		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *ShutdownRequest) GetWorker() uint32 {
+	if m != nil {
+		return m.Worker
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RunResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ShutdownRequest) GetWorkers() uint32 {
+	if m != nil {
+		return m.Workers
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RunResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ShutdownResponse is a response to a ShutdownRequest
+type ShutdownResponse struct {
 }
 
-func (m *RunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency99, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency99):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintBenchmark(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x4a
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency95, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency95):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintBenchmark(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x42
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency75, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency75):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintBenchmark(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x3a
-	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency50, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency50):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintBenchmark(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x32
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency):])
-	if err7 != nil {
-		return 0, err7
+func (m *ShutdownResponse) Reset()         { *m = ShutdownResponse{} }
+func (m *ShutdownResponse) String() string { return proto.CompactTextString(m) }
+func (*ShutdownResponse) ProtoMessage()    {}
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31dca67ba579dd0a, []int{14}
+}
+func (m *ShutdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShutdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShutdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n7
-	i = encodeVarintBenchmark(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x2a
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err8 != nil {
-		return 0, err8
-	}
-	i -= n8
-	i = encodeVarintBenchmark(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x22
-	if m.Requests != 0 {
-		i = encodeVarintBenchmark(dAtA, i, uint64(m.Requests))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Benchmark) > 0 {
-		i -= len(m.Benchmark)
-		copy(dAtA[i:], m.Benchmark)
-		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Suite) > 0 {
-		i -= len(m.Suite)
-		copy(dAtA[i:], m.Suite)
-		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ShutdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShutdownResponse.Merge(m, src)
+}
+func (m *ShutdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShutdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShutdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShutdownResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SuiteRequest)(nil), "onos.test.benchmark.SuiteRequest")
+	proto.RegisterMapType((map[string]string)(nil), "onos.test.benchmark.SuiteRequest.ArgsEntry")
+	proto.RegisterType((*SuiteResponse)(nil), "onos.test.benchmark.SuiteResponse")
+	proto.RegisterType((*BenchmarkRequest)(nil), "onos.test.benchmark.BenchmarkRequest")
+	proto.RegisterMapType((map[string]string)(nil), "onos.test.benchmark.BenchmarkRequest.ArgsEntry")
+	proto.RegisterType((*BenchmarkResponse)(nil), "onos.test.benchmark.BenchmarkResponse")
+	proto.RegisterType((*RunRequest)(nil), "onos.test.benchmark.RunRequest")
+	proto.RegisterMapType((map[string]string)(nil), "onos.test.benchmark.RunRequest.ArgsEntry")
+	proto.RegisterType((*RunResponse)(nil), "onos.test.benchmark.RunResponse")
+	proto.RegisterType((*HistogramBucket)(nil), "onos.test.benchmark.HistogramBucket")
+	proto.RegisterType((*PercentileLatency)(nil), "onos.test.benchmark.PercentileLatency")
+	proto.RegisterType((*Window)(nil), "onos.test.benchmark.Window")
+	proto.RegisterType((*ProgressRequest)(nil), "onos.test.benchmark.ProgressRequest")
+	proto.RegisterType((*ProgressResponse)(nil), "onos.test.benchmark.ProgressResponse")
+	proto.RegisterType((*StopRequest)(nil), "onos.test.benchmark.StopRequest")
+	proto.RegisterType((*StopResponse)(nil), "onos.test.benchmark.StopResponse")
+	proto.RegisterType((*ShutdownRequest)(nil), "onos.test.benchmark.ShutdownRequest")
+	proto.RegisterMapType((map[string]string)(nil), "onos.test.benchmark.ShutdownRequest.ArgsEntry")
+	proto.RegisterType((*ShutdownResponse)(nil), "onos.test.benchmark.ShutdownResponse")
+}
+
+func init() { proto.RegisterFile("benchmark/benchmark.proto", fileDescriptor_31dca67ba579dd0a) }
+
+var fileDescriptor_31dca67ba579dd0a = []byte{
+	// 1113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0xf3, 0xd5, 0xf4, 0x75, 0xd2, 0x24, 0xd3, 0x0a, 0x79, 0x0d, 0x4a, 0x53, 0x8b, 0x56,
+	0x45, 0x48, 0x8e, 0x54, 0x0e, 0x8b, 0x58, 0xad, 0xaa, 0x0d, 0x45, 0x20, 0x3e, 0xa4, 0xe2, 0xac,
+	0xb6, 0x12, 0x12, 0x0a, 0x6e, 0x32, 0x4d, 0xad, 0x26, 0x9e, 0x30, 0x33, 0xee, 0x87, 0xb8, 0xf1,
+	0x0b, 0x38, 0xf2, 0x03, 0xf8, 0x1f, 0x5c, 0xf7, 0xd8, 0x23, 0x27, 0x40, 0xed, 0x95, 0x03, 0x27,
+	0x8e, 0x08, 0x79, 0x66, 0xec, 0xb8, 0x6e, 0xda, 0xa4, 0x69, 0x97, 0x9b, 0xdf, 0x99, 0xf7, 0x7d,
+	0xe6, 0x99, 0xe7, 0x7d, 0x66, 0x32, 0x81, 0x27, 0x07, 0xd8, 0xef, 0x1e, 0x0d, 0x5d, 0x7a, 0xdc,
+	0x8c, 0xbf, 0xec, 0x11, 0x25, 0x9c, 0xa0, 0x15, 0xe2, 0x13, 0x66, 0x73, 0xcc, 0xb8, 0x1d, 0x4f,
+	0x99, 0xab, 0x7d, 0xd2, 0x27, 0x62, 0xbe, 0x19, 0x7e, 0xc9, 0x54, 0xb3, 0xde, 0x27, 0xa4, 0x3f,
+	0xc0, 0x4d, 0x11, 0x1d, 0x04, 0x87, 0xcd, 0x5e, 0x40, 0x5d, 0xee, 0x11, 0x5f, 0xce, 0x5b, 0x17,
+	0x1a, 0x94, 0xda, 0x81, 0xc7, 0xb1, 0x83, 0xbf, 0x0f, 0x30, 0xe3, 0x68, 0x15, 0xf2, 0x2c, 0x8c,
+	0x0d, 0xad, 0xa1, 0x6d, 0x2d, 0x39, 0x32, 0x40, 0x3b, 0x90, 0x73, 0x69, 0x9f, 0x19, 0x99, 0x46,
+	0x76, 0x4b, 0xdf, 0x7e, 0xdf, 0x9e, 0x40, 0xc0, 0x4e, 0xc2, 0xd8, 0x2f, 0x68, 0x9f, 0x7d, 0xe2,
+	0x73, 0x7a, 0xee, 0x88, 0x42, 0xf4, 0x16, 0x14, 0x4e, 0x09, 0x3d, 0xc6, 0xd4, 0xc8, 0x36, 0xb4,
+	0xad, 0xb2, 0xa3, 0x22, 0x64, 0xc0, 0xa2, 0xfc, 0x62, 0x46, 0x4e, 0x4c, 0x44, 0xa1, 0xf9, 0x14,
+	0x96, 0x62, 0x10, 0x54, 0x85, 0xec, 0x31, 0x3e, 0x57, 0x9c, 0xc2, 0xcf, 0x90, 0xe7, 0x89, 0x3b,
+	0x08, 0xb0, 0x91, 0x91, 0x3c, 0x45, 0xf0, 0x51, 0xe6, 0x43, 0xcd, 0xaa, 0x40, 0x59, 0x51, 0x61,
+	0x23, 0xe2, 0x33, 0x6c, 0xfd, 0xa3, 0x41, 0xb5, 0x15, 0xd1, 0xbc, 0x7b, 0x9f, 0xef, 0xc0, 0x52,
+	0xbc, 0x21, 0x85, 0x3c, 0x1e, 0x40, 0x1f, 0x2b, 0x15, 0xb2, 0x42, 0x85, 0xe6, 0x44, 0x15, 0xd2,
+	0x0b, 0xdd, 0xa1, 0x44, 0xee, 0x36, 0x25, 0xf2, 0x8f, 0xa4, 0xc4, 0x0a, 0xd4, 0x12, 0x74, 0x94,
+	0x1a, 0xbf, 0xe4, 0x01, 0x9c, 0xc0, 0x7f, 0x88, 0x0e, 0x26, 0x14, 0xa9, 0x2c, 0x67, 0xaa, 0x9d,
+	0x71, 0x8c, 0x9e, 0x41, 0x31, 0xb2, 0x98, 0xd8, 0xa0, 0xbe, 0xfd, 0xc4, 0x96, 0x1e, 0xb4, 0x23,
+	0x0f, 0xda, 0xbb, 0x2a, 0xa1, 0x95, 0xfb, 0xf9, 0x8f, 0x35, 0xcd, 0x89, 0x0b, 0x50, 0x03, 0xf4,
+	0x91, 0x4b, 0xdd, 0xc1, 0x00, 0x0f, 0x3c, 0x36, 0x54, 0x3a, 0x24, 0x87, 0xd0, 0x73, 0xd5, 0x82,
+	0x82, 0x68, 0xc1, 0x7b, 0x13, 0x5b, 0x30, 0xde, 0xdd, 0x0d, 0xf1, 0x77, 0x00, 0x86, 0xee, 0xd9,
+	0x97, 0x2e, 0xc7, 0x7e, 0xf7, 0xdc, 0x58, 0x9c, 0x8d, 0x5f, 0xa2, 0x04, 0x3d, 0x85, 0xc2, 0xa9,
+	0xe7, 0xf7, 0xc8, 0xa9, 0x51, 0x9c, 0xad, 0x58, 0xa5, 0x27, 0xda, 0xbe, 0x74, 0x5b, 0xdb, 0xe1,
+	0x5a, 0xdb, 0x51, 0x13, 0x56, 0x8e, 0x3c, 0xc6, 0x49, 0x9f, 0xba, 0xc3, 0xce, 0x88, 0xe2, 0xae,
+	0xc7, 0x42, 0x51, 0x75, 0x91, 0x85, 0xe2, 0xa9, 0xbd, 0x68, 0x06, 0xed, 0x42, 0x79, 0x5c, 0x30,
+	0x74, 0xcf, 0x8c, 0xd2, 0x6c, 0x14, 0x4b, 0x71, 0xd5, 0x57, 0xee, 0x99, 0xe8, 0x01, 0xa6, 0x5d,
+	0xec, 0x73, 0x6f, 0x80, 0x99, 0x51, 0x6e, 0x64, 0xb7, 0x32, 0x4e, 0x72, 0x08, 0x21, 0xc8, 0x51,
+	0x97, 0x63, 0x63, 0xb9, 0xa1, 0x6d, 0x69, 0x8e, 0xf8, 0x16, 0xdb, 0x73, 0xe9, 0x30, 0x18, 0x19,
+	0x15, 0xb5, 0x3d, 0x11, 0xcd, 0xef, 0xdd, 0xbf, 0xf2, 0xa0, 0x8b, 0x46, 0x4a, 0xdb, 0x3e, 0xba,
+	0x4f, 0x77, 0xee, 0xe3, 0xd3, 0xe2, 0xeb, 0xdf, 0xd7, 0x16, 0x52, 0x5e, 0x7d, 0x0e, 0x8b, 0x03,
+	0xe5, 0xa3, 0xfc, 0xec, 0xf5, 0x51, 0x0d, 0x7a, 0x06, 0x8b, 0xd2, 0x19, 0x61, 0xdf, 0x43, 0x2f,
+	0xbf, 0x3d, 0xd1, 0xcb, 0xfb, 0x22, 0xa7, 0x95, 0x0b, 0x01, 0x9c, 0xa8, 0x22, 0x54, 0x1b, 0x53,
+	0x4a, 0x28, 0x53, 0x6e, 0x50, 0x11, 0x5a, 0x87, 0x92, 0x7b, 0x40, 0x28, 0xef, 0x50, 0xec, 0x32,
+	0xe2, 0x0b, 0x03, 0x2c, 0x39, 0xba, 0x18, 0x73, 0xc4, 0x10, 0xfa, 0x16, 0x56, 0x14, 0x85, 0x4e,
+	0xba, 0xcd, 0xfa, 0xf6, 0xe6, 0x44, 0x0e, 0x7b, 0x71, 0x9e, 0x3a, 0x05, 0x8a, 0x0e, 0x52, 0x40,
+	0x7b, 0x09, 0x6f, 0xec, 0x82, 0x3e, 0xf4, 0xfc, 0x4e, 0xa4, 0xcc, 0xf2, 0xec, 0xca, 0xc0, 0xd0,
+	0xf3, 0xa3, 0x53, 0x16, 0xa2, 0xb8, 0x67, 0x31, 0x4a, 0xe5, 0x3e, 0x28, 0xe3, 0xb3, 0xfa, 0x05,
+	0x54, 0x18, 0xef, 0x75, 0x7a, 0xf8, 0x24, 0x46, 0xaa, 0xce, 0x8e, 0x54, 0x66, 0xbc, 0xb7, 0x8b,
+	0x4f, 0x22, 0xb0, 0x7d, 0xa8, 0x45, 0xba, 0xc5, 0xc7, 0xc5, 0xa8, 0x09, 0xd5, 0xde, 0x9d, 0xa8,
+	0xda, 0x67, 0x51, 0x56, 0x2b, 0xe8, 0x1e, 0x63, 0xae, 0x34, 0xab, 0x2a, 0x90, 0x78, 0xf6, 0xf3,
+	0x5c, 0xb1, 0x50, 0x05, 0xeb, 0x10, 0x2a, 0xa9, 0x82, 0xa4, 0xc1, 0xb4, 0x39, 0x0c, 0xb6, 0x0a,
+	0xf9, 0x2e, 0x09, 0x7c, 0x2e, 0x8e, 0x45, 0xce, 0x91, 0x81, 0x45, 0xa1, 0x76, 0xa3, 0x9d, 0xa8,
+	0x0e, 0x30, 0xf6, 0x82, 0x58, 0x2c, 0xe3, 0x24, 0x46, 0x92, 0x4c, 0x32, 0xf7, 0x67, 0x62, 0xfd,
+	0xaa, 0x41, 0x41, 0xfa, 0x38, 0x24, 0xe5, 0xf9, 0x3d, 0x7c, 0x26, 0x16, 0x29, 0x3b, 0x32, 0xb8,
+	0x76, 0x4e, 0x33, 0xa9, 0x73, 0x9a, 0x58, 0x3b, 0x3b, 0x87, 0x0a, 0x29, 0x27, 0xe5, 0xe6, 0x72,
+	0x92, 0xf5, 0x03, 0x54, 0xf6, 0x28, 0xe9, 0x53, 0xcc, 0xd8, 0x43, 0x7e, 0x37, 0x57, 0x21, 0xcf,
+	0x09, 0x77, 0x07, 0x62, 0x27, 0x45, 0x47, 0x06, 0x29, 0xf5, 0x73, 0x69, 0xf5, 0xad, 0x1f, 0x33,
+	0x50, 0x1d, 0xaf, 0xae, 0xae, 0xc3, 0xa4, 0x64, 0xda, 0xed, 0x92, 0xcd, 0xd1, 0x2e, 0xe4, 0x00,
+	0x1a, 0xaf, 0xde, 0x99, 0x43, 0xfc, 0xda, 0xe8, 0x86, 0xc3, 0x1e, 0x7a, 0xdb, 0x5a, 0x2f, 0x40,
+	0x6f, 0x73, 0x32, 0x7a, 0x80, 0xfa, 0xd6, 0x32, 0x94, 0x24, 0x84, 0x7a, 0x08, 0xfd, 0xab, 0x41,
+	0xa5, 0x7d, 0x14, 0xf0, 0x1e, 0x39, 0x9d, 0xf2, 0x1a, 0x6a, 0x5d, 0x7b, 0xfd, 0xda, 0x93, 0x5f,
+	0xbf, 0xd7, 0x91, 0x6e, 0xbc, 0x3c, 0x36, 0xa1, 0xc2, 0xb1, 0x4b, 0x3b, 0x61, 0x4e, 0x47, 0xae,
+	0x21, 0x5d, 0x50, 0x0e, 0x87, 0x77, 0xc9, 0xa9, 0x2f, 0x1e, 0xad, 0xff, 0xe7, 0xf3, 0x10, 0x41,
+	0x75, 0xcc, 0x5a, 0x8a, 0xb2, 0xfd, 0xf7, 0x22, 0x94, 0xf7, 0x05, 0x70, 0x1b, 0xd3, 0x13, 0xaf,
+	0x8b, 0x51, 0x1b, 0xa0, 0x8d, 0x79, 0x30, 0x92, 0xf4, 0xd6, 0xa7, 0x3e, 0xfd, 0x4d, 0xeb, 0xae,
+	0x14, 0x65, 0xdf, 0x57, 0x50, 0x7e, 0x79, 0x6d, 0xdb, 0x8f, 0x84, 0xfb, 0x12, 0x74, 0x41, 0x56,
+	0x6e, 0xe1, 0xb1, 0x50, 0xf7, 0x61, 0x39, 0x62, 0xfb, 0xb8, 0xc0, 0x1d, 0x58, 0x16, 0x74, 0xe3,
+	0x57, 0x3a, 0xda, 0x98, 0xe9, 0x4f, 0x85, 0xb9, 0x39, 0x2d, 0x4d, 0x2d, 0x70, 0x00, 0xb5, 0x88,
+	0xf9, 0x1b, 0x5b, 0xe3, 0x6b, 0x28, 0x39, 0x41, 0x02, 0x7e, 0x6d, 0xca, 0xa3, 0xdc, 0x6c, 0xdc,
+	0x9e, 0xa0, 0x20, 0xbf, 0x83, 0xca, 0x2b, 0x4c, 0xbd, 0xc3, 0xf3, 0x37, 0x46, 0xfa, 0x1b, 0xd0,
+	0x3f, 0xc5, 0x3c, 0xba, 0x56, 0xd1, 0xe4, 0x9f, 0xf0, 0xd4, 0x9d, 0x6f, 0x6e, 0x4c, 0xc9, 0x8a,
+	0x4d, 0x58, 0x0e, 0x2f, 0x9a, 0x31, 0xf7, 0xc9, 0x1b, 0x4e, 0xdc, 0x67, 0xe6, 0xfa, 0x1d, 0x19,
+	0xb1, 0x09, 0x8b, 0xd1, 0x69, 0xbd, 0x85, 0x6e, 0xea, 0x0a, 0x32, 0x37, 0xa6, 0x64, 0x49, 0xe0,
+	0x96, 0xf1, 0xfa, 0xb2, 0xae, 0x5d, 0x5c, 0xd6, 0xb5, 0x3f, 0x2f, 0xeb, 0xda, 0x4f, 0x57, 0xf5,
+	0x85, 0x8b, 0xab, 0xfa, 0xc2, 0x6f, 0x57, 0xf5, 0x85, 0x83, 0x82, 0xb8, 0x9b, 0x3f, 0xf8, 0x6f,
+	0x00, 0x96, 0x81, 0xdc, 0xff, 0x8b, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkerServiceClient is the client API for WorkerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkerServiceClient interface {
+	SetupSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
+	TearDownSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
+	SetupWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
+	TearDownWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error)
+	SetupBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
+	TearDownBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
+	RunBenchmark(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error)
+	VerifyBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
+	GetProgress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (*ProgressResponse, error)
+	StopBenchmark(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
+}
+
+type workerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkerServiceClient(cc *grpc.ClientConn) WorkerServiceClient {
+	return &workerServiceClient{cc}
+}
+
+func (c *workerServiceClient) SetupSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
+	out := new(SuiteResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/SetupSuite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintBenchmark(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBenchmark(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *workerServiceClient) TearDownSuite(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
+	out := new(SuiteResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/TearDownSuite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *SuiteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *workerServiceClient) SetupWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
+	out := new(SuiteResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/SetupWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Suite)
-	if l > 0 {
-		n += 1 + l + sovBenchmark(uint64(l))
+	return out, nil
+}
+
+func (c *workerServiceClient) TearDownWorker(ctx context.Context, in *SuiteRequest, opts ...grpc.CallOption) (*SuiteResponse, error) {
+	out := new(SuiteResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/TearDownWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Args) > 0 {
-		for k, v := range m.Args {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovBenchmark(uint64(len(k))) + 1 + len(v) + sovBenchmark(uint64(len(v)))
-			n += mapEntrySize + 1 + sovBenchmark(uint64(mapEntrySize))
-		}
+	return out, nil
+}
+
+func (c *workerServiceClient) SetupBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
+	out := new(BenchmarkResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/SetupBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SuiteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *workerServiceClient) TearDownBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
+	out := new(BenchmarkResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/TearDownBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *BenchmarkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *workerServiceClient) RunBenchmark(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error) {
+	out := new(RunResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/RunBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Suite)
-	if l > 0 {
-		n += 1 + l + sovBenchmark(uint64(l))
+	return out, nil
+}
+
+func (c *workerServiceClient) VerifyBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
+	out := new(BenchmarkResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/VerifyBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Benchmark)
-	if l > 0 {
-		n += 1 + l + sovBenchmark(uint64(l))
+	return out, nil
+}
+
+func (c *workerServiceClient) GetProgress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (*ProgressResponse, error) {
+	out := new(ProgressResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/GetProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Args) > 0 {
-		for k, v := range m.Args {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovBenchmark(uint64(len(k))) + 1 + len(v) + sovBenchmark(uint64(len(v)))
-			n += mapEntrySize + 1 + sovBenchmark(uint64(mapEntrySize))
-		}
+	return out, nil
+}
+
+func (c *workerServiceClient) StopBenchmark(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
+	out := new(StopResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/StopBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BenchmarkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *workerServiceClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error) {
+	out := new(ShutdownResponse)
+	err := c.cc.Invoke(ctx, "/onos.test.benchmark.WorkerService/Shutdown", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *RunRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// WorkerServiceServer is the server API for WorkerService service.
+type WorkerServiceServer interface {
+	SetupSuite(context.Context, *SuiteRequest) (*SuiteResponse, error)
+	TearDownSuite(context.Context, *SuiteRequest) (*SuiteResponse, error)
+	SetupWorker(context.Context, *SuiteRequest) (*SuiteResponse, error)
+	TearDownWorker(context.Context, *SuiteRequest) (*SuiteResponse, error)
+	SetupBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResponse, error)
+	TearDownBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResponse, error)
+	RunBenchmark(context.Context, *RunRequest) (*RunResponse, error)
+	VerifyBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResponse, error)
+	GetProgress(context.Context, *ProgressRequest) (*ProgressResponse, error)
+	StopBenchmark(context.Context, *StopRequest) (*StopResponse, error)
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+}
+
+// UnimplementedWorkerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkerServiceServer struct {
+}
+
+func (*UnimplementedWorkerServiceServer) SetupSuite(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupSuite not implemented")
+}
+func (*UnimplementedWorkerServiceServer) TearDownSuite(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TearDownSuite not implemented")
+}
+func (*UnimplementedWorkerServiceServer) SetupWorker(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupWorker not implemented")
+}
+func (*UnimplementedWorkerServiceServer) TearDownWorker(ctx context.Context, req *SuiteRequest) (*SuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TearDownWorker not implemented")
+}
+func (*UnimplementedWorkerServiceServer) SetupBenchmark(ctx context.Context, req *BenchmarkRequest) (*BenchmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupBenchmark not implemented")
+}
+func (*UnimplementedWorkerServiceServer) TearDownBenchmark(ctx context.Context, req *BenchmarkRequest) (*BenchmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TearDownBenchmark not implemented")
+}
+func (*UnimplementedWorkerServiceServer) RunBenchmark(ctx context.Context, req *RunRequest) (*RunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunBenchmark not implemented")
+}
+func (*UnimplementedWorkerServiceServer) VerifyBenchmark(ctx context.Context, req *BenchmarkRequest) (*BenchmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBenchmark not implemented")
+}
+func (*UnimplementedWorkerServiceServer) GetProgress(ctx context.Context, req *ProgressRequest) (*ProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgress not implemented")
+}
+func (*UnimplementedWorkerServiceServer) StopBenchmark(ctx context.Context, req *StopRequest) (*StopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopBenchmark not implemented")
+}
+func (*UnimplementedWorkerServiceServer) Shutdown(ctx context.Context, req *ShutdownRequest) (*ShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+
+func RegisterWorkerServiceServer(s *grpc.Server, srv WorkerServiceServer) {
+	s.RegisterService(&_WorkerService_serviceDesc, srv)
+}
+
+func _WorkerService_SetupSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Suite)
-	if l > 0 {
-		n += 1 + l + sovBenchmark(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).SetupSuite(ctx, in)
 	}
-	l = len(m.Benchmark)
-	if l > 0 {
-		n += 1 + l + sovBenchmark(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/SetupSuite",
 	}
-	if m.Requests != 0 {
-		n += 1 + sovBenchmark(uint64(m.Requests))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).SetupSuite(ctx, req.(*SuiteRequest))
 	}
-	if m.Duration != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration)
-		n += 1 + l + sovBenchmark(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_TearDownSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Parallelism != 0 {
-		n += 1 + sovBenchmark(uint64(m.Parallelism))
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).TearDownSuite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/TearDownSuite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).TearDownSuite(ctx, req.(*SuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_SetupWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).SetupWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/SetupWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).SetupWorker(ctx, req.(*SuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_TearDownWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).TearDownWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/TearDownWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).TearDownWorker(ctx, req.(*SuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_SetupBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).SetupBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/SetupBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).SetupBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_TearDownBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).TearDownBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/TearDownBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).TearDownBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_RunBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).RunBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/RunBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).RunBenchmark(ctx, req.(*RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_VerifyBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).VerifyBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/VerifyBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).VerifyBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_GetProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).GetProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/GetProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).GetProgress(ctx, req.(*ProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_StopBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).StopBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/StopBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).StopBenchmark(ctx, req.(*StopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.test.benchmark.WorkerService/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WorkerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "onos.test.benchmark.WorkerService",
+	HandlerType: (*WorkerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetupSuite",
+			Handler:    _WorkerService_SetupSuite_Handler,
+		},
+		{
+			MethodName: "TearDownSuite",
+			Handler:    _WorkerService_TearDownSuite_Handler,
+		},
+		{
+			MethodName: "SetupWorker",
+			Handler:    _WorkerService_SetupWorker_Handler,
+		},
+		{
+			MethodName: "TearDownWorker",
+			Handler:    _WorkerService_TearDownWorker_Handler,
+		},
+		{
+			MethodName: "SetupBenchmark",
+			Handler:    _WorkerService_SetupBenchmark_Handler,
+		},
+		{
+			MethodName: "TearDownBenchmark",
+			Handler:    _WorkerService_TearDownBenchmark_Handler,
+		},
+		{
+			MethodName: "RunBenchmark",
+			Handler:    _WorkerService_RunBenchmark_Handler,
+		},
+		{
+			MethodName: "VerifyBenchmark",
+			Handler:    _WorkerService_VerifyBenchmark_Handler,
+		},
+		{
+			MethodName: "GetProgress",
+			Handler:    _WorkerService_GetProgress_Handler,
+		},
+		{
+			MethodName: "StopBenchmark",
+			Handler:    _WorkerService_StopBenchmark_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _WorkerService_Shutdown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "benchmark/benchmark.proto",
+}
+
+func (m *SuiteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuiteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuiteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Workers != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Workers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Worker != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Worker))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Args) > 0 {
-		for k, v := range m.Args {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovBenchmark(uint64(len(k))) + 1 + len(v) + sovBenchmark(uint64(len(v)))
-			n += mapEntrySize + 1 + sovBenchmark(uint64(mapEntrySize))
+		for k := range m.Args {
+			v := m.Args[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintBenchmark(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Suite) > 0 {
+		i -= len(m.Suite)
+		copy(dAtA[i:], m.Suite)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuiteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuiteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuiteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BenchmarkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BenchmarkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BenchmarkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Workers != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Workers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Worker != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Worker))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Args) > 0 {
+		for k := range m.Args {
+			v := m.Args[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintBenchmark(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Benchmark) > 0 {
+		i -= len(m.Benchmark)
+		copy(dAtA[i:], m.Benchmark)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Suite) > 0 {
+		i -= len(m.Suite)
+		copy(dAtA[i:], m.Suite)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BenchmarkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BenchmarkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BenchmarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Warmup != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Warmup))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Rate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rate))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float32bits(float32(m.Percentiles[iNdEx]))
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f1))
+		}
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Percentiles)*4))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.HistogramMax != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HistogramMax, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HistogramMax):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintBenchmark(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.HistogramPrecision != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.HistogramPrecision))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Workers != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Workers))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Worker != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Worker))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Window != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Window):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintBenchmark(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxLatency != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxLatency):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintBenchmark(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Args) > 0 {
+		for k := range m.Args {
+			v := m.Args[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintBenchmark(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Parallelism != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Parallelism))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Duration != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintBenchmark(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Requests != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Requests))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Benchmark) > 0 {
+		i -= len(m.Benchmark)
+		copy(dAtA[i:], m.Benchmark)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Suite) > 0 {
+		i -= len(m.Suite)
+		copy(dAtA[i:], m.Suite)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LatencyHistogram) > 0 {
+		for iNdEx := len(m.LatencyHistogram) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatencyHistogram[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBenchmark(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.StdDevLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.StdDevLatency):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBenchmark(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxLatency):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintBenchmark(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x7a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinLatency):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintBenchmark(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x72
+	if len(m.LatencyPercentiles) > 0 {
+		for iNdEx := len(m.LatencyPercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatencyPercentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBenchmark(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AbortReason) > 0 {
+		i -= len(m.AbortReason)
+		copy(dAtA[i:], m.AbortReason)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.AbortReason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Errors != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Errors))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBenchmark(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintBenchmark(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintBenchmark(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if m.Requests != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Requests))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Benchmark) > 0 {
+		i -= len(m.Benchmark)
+		copy(dAtA[i:], m.Benchmark)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Suite) > 0 {
+		i -= len(m.Suite)
+		copy(dAtA[i:], m.Suite)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistogramBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistogramBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistogramBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintBenchmark(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PercentileLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentileLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentileLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintBenchmark(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if m.Percentile != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Percentile))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Window) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Window) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxLatency):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintBenchmark(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintBenchmark(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if m.Requests != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Requests))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Percentile))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.Total {
+		i--
+		if m.Total {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Benchmark) > 0 {
+		i -= len(m.Benchmark)
+		copy(dAtA[i:], m.Benchmark)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Suite) > 0 {
+		i -= len(m.Suite)
+		copy(dAtA[i:], m.Suite)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintBenchmark(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PercentileLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PercentileLatency):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintBenchmark(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x1a
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintBenchmark(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if m.Requests != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Requests))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Benchmark) > 0 {
+		i -= len(m.Benchmark)
+		copy(dAtA[i:], m.Benchmark)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Benchmark)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Suite) > 0 {
+		i -= len(m.Suite)
+		copy(dAtA[i:], m.Suite)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ShutdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShutdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShutdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Workers != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Workers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Worker != 0 {
+		i = encodeVarintBenchmark(dAtA, i, uint64(m.Worker))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TearDownSuite {
+		i--
+		if m.TearDownSuite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Args) > 0 {
+		for k := range m.Args {
+			v := m.Args[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintBenchmark(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintBenchmark(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Suite) > 0 {
+		i -= len(m.Suite)
+		copy(dAtA[i:], m.Suite)
+		i = encodeVarintBenchmark(dAtA, i, uint64(len(m.Suite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShutdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShutdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShutdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBenchmark(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBenchmark(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SuiteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Suite)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBenchmark(uint64(len(k))) + 1 + len(v) + sovBenchmark(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBenchmark(uint64(mapEntrySize))
+		}
+	}
+	if m.Worker != 0 {
+		n += 1 + sovBenchmark(uint64(m.Worker))
+	}
+	if m.Workers != 0 {
+		n += 1 + sovBenchmark(uint64(m.Workers))
+	}
+	return n
+}
+
+func (m *SuiteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BenchmarkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Suite)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	l = len(m.Benchmark)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBenchmark(uint64(len(k))) + 1 + len(v) + sovBenchmark(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBenchmark(uint64(mapEntrySize))
+		}
+	}
+	if m.Worker != 0 {
+		n += 1 + sovBenchmark(uint64(m.Worker))
+	}
+	if m.Workers != 0 {
+		n += 1 + sovBenchmark(uint64(m.Workers))
+	}
+	return n
+}
+
+func (m *BenchmarkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Suite)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	l = len(m.Benchmark)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if m.Requests != 0 {
+		n += 1 + sovBenchmark(uint64(m.Requests))
+	}
+	if m.Duration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration)
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if m.Parallelism != 0 {
+		n += 1 + sovBenchmark(uint64(m.Parallelism))
+	}
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBenchmark(uint64(len(k))) + 1 + len(v) + sovBenchmark(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBenchmark(uint64(mapEntrySize))
+		}
+	}
+	if m.MaxLatency != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxLatency)
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if m.Window != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Window)
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if m.Worker != 0 {
+		n += 1 + sovBenchmark(uint64(m.Worker))
+	}
+	if m.Workers != 0 {
+		n += 1 + sovBenchmark(uint64(m.Workers))
+	}
+	if m.HistogramPrecision != 0 {
+		n += 1 + sovBenchmark(uint64(m.HistogramPrecision))
+	}
+	if m.HistogramMax != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HistogramMax)
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if len(m.Percentiles) > 0 {
+		n += 1 + sovBenchmark(uint64(len(m.Percentiles)*4)) + len(m.Percentiles)*4
+	}
+	if m.Rate != 0 {
+		n += 9
+	}
+	if m.Warmup != 0 {
+		n += 1 + sovBenchmark(uint64(m.Warmup))
+	}
+	return n
+}
+
+func (m *RunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Suite)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	l = len(m.Benchmark)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if m.Requests != 0 {
+		n += 1 + sovBenchmark(uint64(m.Requests))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovBenchmark(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovBenchmark(uint64(l))
+		}
+	}
+	if m.Errors != 0 {
+		n += 1 + sovBenchmark(uint64(m.Errors))
+	}
+	l = len(m.AbortReason)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if len(m.LatencyPercentiles) > 0 {
+		for _, e := range m.LatencyPercentiles {
+			l = e.Size()
+			n += 1 + l + sovBenchmark(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinLatency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxLatency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.StdDevLatency)
+	n += 2 + l + sovBenchmark(uint64(l))
+	if len(m.LatencyHistogram) > 0 {
+		for _, e := range m.LatencyHistogram {
+			l = e.Size()
+			n += 2 + l + sovBenchmark(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistogramBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovBenchmark(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *PercentileLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 5
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	return n
+}
+
+func (m *Window) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBenchmark(uint64(m.Index))
+	}
+	if m.Requests != 0 {
+		n += 1 + sovBenchmark(uint64(m.Requests))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxLatency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	return n
+}
+
+func (m *ProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Suite)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	l = len(m.Benchmark)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if m.Total {
+		n += 2
+	}
+	if m.Percentile != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *ProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Requests != 0 {
+		n += 1 + sovBenchmark(uint64(m.Requests))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PercentileLatency)
+	n += 1 + l + sovBenchmark(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovBenchmark(uint64(l))
+	return n
+}
+
+func (m *StopRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Suite)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	l = len(m.Benchmark)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	return n
+}
+
+func (m *StopResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ShutdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Suite)
+	if l > 0 {
+		n += 1 + l + sovBenchmark(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBenchmark(uint64(len(k))) + 1 + len(v) + sovBenchmark(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBenchmark(uint64(mapEntrySize))
+		}
+	}
+	if m.TearDownSuite {
+		n += 2
+	}
+	if m.Worker != 0 {
+		n += 1 + sovBenchmark(uint64(m.Worker))
+	}
+	if m.Workers != 0 {
+		n += 1 + sovBenchmark(uint64(m.Workers))
+	}
+	return n
+}
+
+func (m *ShutdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovBenchmark(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBenchmark(x uint64) (n int) {
+	return sovBenchmark(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SuiteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuiteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuiteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Args == nil {
+				m.Args = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBenchmark
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBenchmark(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Args[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			m.Worker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Worker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			m.Workers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Workers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuiteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuiteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuiteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BenchmarkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BenchmarkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BenchmarkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Benchmark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Benchmark = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Args == nil {
+				m.Args = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBenchmark
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBenchmark(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Args[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			m.Worker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Worker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			m.Workers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Workers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BenchmarkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BenchmarkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BenchmarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Benchmark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Benchmark = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Args == nil {
+				m.Args = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBenchmark
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBenchmark(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Args[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxLatency == nil {
+				m.MaxLatency = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			m.Worker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Worker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			m.Workers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Workers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramPrecision", wireType)
+			}
+			m.HistogramPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistogramPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistogramMax == nil {
+				m.HistogramMax = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HistogramMax, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.Percentiles = append(m.Percentiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBenchmark
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBenchmark
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBenchmark
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.Percentiles = append(m.Percentiles, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rate = float64(math.Float64frombits(v))
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warmup", wireType)
+			}
+			m.Warmup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Warmup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Benchmark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Benchmark = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, Window{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			m.Errors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errors |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbortReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyPercentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatencyPercentiles = append(m.LatencyPercentiles, PercentileLatency{})
+			if err := m.LatencyPercentiles[len(m.LatencyPercentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdDevLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.StdDevLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyHistogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatencyHistogram = append(m.LatencyHistogram, HistogramBucket{})
+			if err := m.LatencyHistogram[len(m.LatencyHistogram)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.MaxLatency != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxLatency)
-		n += 1 + l + sovBenchmark(uint64(l))
-	}
-	return n
-}
 
-func (m *RunResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Suite)
-	if l > 0 {
-		n += 1 + l + sovBenchmark(uint64(l))
-	}
-	l = len(m.Benchmark)
-	if l > 0 {
-		n += 1 + l + sovBenchmark(uint64(l))
-	}
-	if m.Requests != 0 {
-		n += 1 + sovBenchmark(uint64(m.Requests))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovBenchmark(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency)
-	n += 1 + l + sovBenchmark(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency50)
-	n += 1 + l + sovBenchmark(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency75)
-	n += 1 + l + sovBenchmark(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency95)
-	n += 1 + l + sovBenchmark(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency99)
-	n += 1 + l + sovBenchmark(uint64(l))
-	return n
-}
-
-func sovBenchmark(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBenchmark(x uint64) (n int) {
-	return sovBenchmark(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SuiteRequest) Unmarshal(dAtA []byte) error {
+func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1292,17 +4530,17 @@ func (m *SuiteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuiteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistogramBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuiteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistogramBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -1312,29 +4550,30 @@ func (m *SuiteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBenchmark
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suite = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -1344,129 +4583,18 @@ func (m *SuiteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Args == nil {
-				m.Args = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBenchmark
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBenchmark
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBenchmark
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipBenchmark(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Args[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBenchmark(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if (iNdEx + skippy) > l {
@@ -1481,7 +4609,7 @@ func (m *SuiteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuiteResponse) Unmarshal(dAtA []byte) error {
+func (m *PercentileLatency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1504,22 +4632,63 @@ func (m *SuiteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuiteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PercentileLatency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuiteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PercentileLatency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Percentile = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBenchmark(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if (iNdEx + skippy) > l {
@@ -1534,7 +4703,7 @@ func (m *SuiteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BenchmarkRequest) Unmarshal(dAtA []byte) error {
+func (m *Window) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,17 +4726,17 @@ func (m *BenchmarkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BenchmarkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Window: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BenchmarkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Window: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suite", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -1577,29 +4746,35 @@ func (m *BenchmarkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBenchmark
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Suite = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Benchmark", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -1609,27 +4784,28 @@ func (m *BenchmarkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBenchmark
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Benchmark = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1656,167 +4832,17 @@ func (m *BenchmarkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Args == nil {
-				m.Args = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBenchmark
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBenchmark
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBenchmark
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipBenchmark(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Args[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBenchmark(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxLatency, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BenchmarkResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBenchmark
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BenchmarkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BenchmarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBenchmark(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if (iNdEx + skippy) > l {
@@ -1831,7 +4857,7 @@ func (m *BenchmarkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunRequest) Unmarshal(dAtA []byte) error {
+func (m *ProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,10 +4880,10 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1919,12 +4945,93 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthBenchmark
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Benchmark = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBenchmark
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Total = bool(v != 0)
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Percentile = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Benchmark = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
@@ -1943,9 +5050,9 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1972,35 +5079,13 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
-			}
-			m.Parallelism = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBenchmark
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Parallelism |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentileLatency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2027,107 +5112,13 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Args == nil {
-				m.Args = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBenchmark
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBenchmark
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBenchmark
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipBenchmark(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthBenchmark
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PercentileLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Args[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2154,10 +5145,7 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxLatency == nil {
-				m.MaxLatency = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxLatency, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2167,10 +5155,7 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if (iNdEx + skippy) > l {
@@ -2185,7 +5170,7 @@ func (m *RunRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunResponse) Unmarshal(dAtA []byte) error {
+func (m *StopRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2208,10 +5193,10 @@ func (m *RunResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2278,63 +5263,111 @@ func (m *RunResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Benchmark = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Requests = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBenchmark
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Requests |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBenchmark
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBenchmark
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBenchmark
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBenchmark
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShutdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShutdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShutdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suite", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -2344,28 +5377,27 @@ func (m *RunResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBenchmark
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Suite = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latency50", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2392,15 +5424,109 @@ func (m *RunResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency50, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Args == nil {
+				m.Args = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBenchmark
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBenchmark
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBenchmark(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthBenchmark
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Args[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latency75", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TearDownSuite", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -2410,30 +5536,17 @@ func (m *RunResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency75, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latency95", wireType)
+			m.TearDownSuite = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var msglen int
+			m.Worker = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -2443,30 +5556,16 @@ func (m *RunResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Worker |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency95, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latency99", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
-			var msglen int
+			m.Workers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBenchmark
@@ -2476,35 +5575,68 @@ func (m *RunResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Workers |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBenchmark
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBenchmark(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBenchmark
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency99, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShutdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBenchmark
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShutdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShutdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBenchmark(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBenchmark
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBenchmark
 			}
 			if (iNdEx + skippy) > l {
@@ -0,0 +1,236 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/onosproject/helmit/pkg/job"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// checkpoint is the persisted progress of a benchmark
+// Checkpoints are stored in a ConfigMap in the coordinator's namespace, which outlives the benchmark jobs,
+// so that a failed run can be resumed with --resume.
+type checkpoint struct {
+	Completed   bool                `json:"completed,omitempty"`
+	Requests    int                 `json:"requests"`
+	Duration    time.Duration       `json:"duration"`
+	Latency     time.Duration       `json:"latency"`
+	MinLatency  time.Duration       `json:"minLatency,omitempty"`
+	MaxLatency  time.Duration       `json:"maxLatency,omitempty"`
+	StdDev      time.Duration       `json:"stdDev,omitempty"`
+	Percentiles []PercentileLatency `json:"percentiles,omitempty"`
+}
+
+// merge returns the checkpoint with the progress of the given prior checkpoint added
+func (c *checkpoint) merge(prior *checkpoint) *checkpoint {
+	if prior == nil {
+		return c
+	}
+	merged := *c
+	merged.Requests = c.Requests + prior.Requests
+	merged.Duration = c.Duration + prior.Duration
+	if merged.Requests > 0 {
+		merged.Latency = (c.Latency*time.Duration(c.Requests) + prior.Latency*time.Duration(prior.Requests)) / time.Duration(merged.Requests)
+	}
+	return &merged
+}
+
+// newCheckpoint returns a completed checkpoint for the given result
+func newCheckpoint(result result) *checkpoint {
+	return &checkpoint{
+		Completed:   true,
+		Requests:    result.requests,
+		Duration:    result.duration,
+		Latency:     result.meanLatency,
+		MinLatency:  result.minLatency,
+		MaxLatency:  result.maxLatency,
+		StdDev:      result.stdDevLatency,
+		Percentiles: newPercentileLatencies(result.latencyPercentiles),
+	}
+}
+
+// result returns the benchmark result recorded by the checkpoint
+func (c *checkpoint) result(benchmark string, workers int) result {
+	var throughput float64
+	if c.Duration > 0 {
+		throughput = float64(c.Requests) / c.Duration.Seconds()
+	}
+	return result{
+		benchmark:          benchmark,
+		workers:            workers,
+		requests:           c.Requests,
+		duration:           c.Duration,
+		throughput:         throughput,
+		meanLatency:        c.Latency,
+		minLatency:         c.MinLatency,
+		maxLatency:         c.MaxLatency,
+		stdDevLatency:      c.StdDev,
+		latencyPercentiles: newLatencyPercentiles(c.Percentiles),
+	}
+}
+
+// mergeResult adds the progress recorded by the prior checkpoint to the given result
+// Latency percentiles cannot be merged from a checkpoint, so the percentiles of the resumed run are reported.
+func mergeResult(r result, prior *checkpoint) result {
+	if prior == nil {
+		return r
+	}
+	merged := (&checkpoint{
+		Requests: r.requests,
+		Duration: r.duration,
+		Latency:  r.meanLatency,
+	}).merge(prior)
+	r.requests = merged.Requests
+	r.duration = merged.Duration
+	r.meanLatency = merged.Latency
+	if r.duration > 0 {
+		r.throughput = float64(r.requests) / r.duration.Seconds()
+	}
+	return r
+}
+
+// getCheckpointName returns the name of the ConfigMap in which checkpoints for the given run are stored
+func getCheckpointName(id string) string {
+	return fmt.Sprintf("%s-checkpoint", id)
+}
+
+// getCheckpointKey returns the key of the checkpoint for the given benchmark
+func getCheckpointKey(suite, benchmark string) string {
+	return fmt.Sprintf("%s.%s", suite, benchmark)
+}
+
+// checkpointStore stores benchmark checkpoints in a ConfigMap
+type checkpointStore struct {
+	runner *job.Runner
+	id     string
+}
+
+// load loads the checkpoint for the given benchmark, returning nil if no checkpoint exists
+func (s *checkpointStore) load(suite, benchmark string) (*checkpoint, error) {
+	cm, err := s.runner.Clientset().CoreV1().ConfigMaps(s.runner.Namespace()).Get(context.Background(), getCheckpointName(s.id), metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	value, ok := cm.Data[getCheckpointKey(suite, benchmark)]
+	if !ok {
+		return nil, nil
+	}
+	checkpoint := &checkpoint{}
+	if err := json.Unmarshal([]byte(value), checkpoint); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+// save saves the checkpoint for the given benchmark
+func (s *checkpointStore) save(suite, benchmark string, checkpoint *checkpoint) error {
+	bytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	client := s.runner.Clientset().CoreV1().ConfigMaps(s.runner.Namespace())
+	cm, err := client.Get(context.Background(), getCheckpointName(s.id), metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      getCheckpointName(s.id),
+				Namespace: s.runner.Namespace(),
+				Labels: map[string]string{
+					"benchmark": s.id,
+				},
+			},
+			Data: map[string]string{
+				getCheckpointKey(suite, benchmark): string(bytes),
+			},
+		}
+		_, err = client.Create(context.Background(), cm, metav1.CreateOptions{})
+		return err
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[getCheckpointKey(suite, benchmark)] = string(bytes)
+	_, err = client.Update(context.Background(), cm, metav1.UpdateOptions{})
+	return err
+}
+
+// delete deletes the checkpoints for the run
+func (s *checkpointStore) delete() error {
+	err := s.runner.Clientset().CoreV1().ConfigMaps(s.runner.Namespace()).Delete(context.Background(), getCheckpointName(s.id), metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// checkpointProgress periodically saves the total progress of the given running benchmark until the context is done
+func (t *WorkerTask) checkpointProgress(ctx context.Context, benchmark string, workers []WorkerServiceClient, prior *checkpoint) {
+	ticker := time.NewTicker(t.config.CheckpointInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		var requests uint32
+		var latencySum time.Duration
+		var elapsed time.Duration
+		for _, worker := range workers {
+			progress, err := worker.GetProgress(ctx, &ProgressRequest{
+				Suite:     t.config.Suite,
+				Benchmark: benchmark,
+				Total:     true,
+			})
+			if err != nil {
+				continue
+			}
+			requests += progress.Requests
+			latencySum += progress.Latency * time.Duration(progress.Requests)
+			if progress.Duration > elapsed {
+				elapsed = progress.Duration
+			}
+		}
+
+		// Requests are not recorded while the benchmark is warming up
+		if requests == 0 || elapsed <= 0 {
+			continue
+		}
+		checkpoint := (&checkpoint{
+			Requests: int(requests),
+			Duration: elapsed,
+			Latency:  latencySum / time.Duration(requests),
+		}).merge(prior)
+		if err := t.checkpoints.save(t.config.Suite, benchmark, checkpoint); err != nil {
+			fmt.Printf("failed to save checkpoint for benchmark %s: %v\n", benchmark, err)
+		}
+	}
+}
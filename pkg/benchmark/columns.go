@@ -0,0 +1,218 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// column is a column of the benchmark results table
+type column struct {
+	header string
+	value  func(result result, format formatter) string
+}
+
+// latencyColumn returns a column of the given latency percentile
+func latencyColumn(percentile float32) column {
+	header := fmt.Sprintf("%s%% LATENCY", formatPercent(percentile))
+	if samePercentile(percentile, .5) {
+		header = "MEDIAN LATENCY"
+	}
+	return column{
+		header: header,
+		value: func(result result, format formatter) string {
+			return format.latency(result.latencyPercentiles.get(percentile))
+		},
+	}
+}
+
+// columns is the columns that can be printed in the results table, by name
+var columns = map[string]column{
+	"benchmark": {
+		header: "BENCHMARK",
+		value: func(result result, format formatter) string {
+			if result.incomplete {
+				return result.benchmark + " (INCOMPLETE)"
+			}
+			return result.benchmark
+		},
+	},
+	"workers": {
+		header: "WORKERS",
+		value: func(result result, format formatter) string {
+			return format.count(result.workers)
+		},
+	},
+	"requests": {
+		header: "REQUESTS",
+		value: func(result result, format formatter) string {
+			return format.count(result.requests)
+		},
+	},
+	"errors": {
+		header: "ERRORS",
+		value: func(result result, format formatter) string {
+			return format.count(result.errors)
+		},
+	},
+	"error-rate": {
+		header: "ERROR RATE",
+		value: func(result result, format formatter) string {
+			if result.requests == 0 {
+				return "0.00%"
+			}
+			return fmt.Sprintf("%.2f%%", float64(result.errors)/float64(result.requests)*100)
+		},
+	},
+	"duration": {
+		header: "DURATION",
+		value: func(result result, format formatter) string {
+			return result.duration.String()
+		},
+	},
+	"throughput": {
+		header: "THROUGHPUT",
+		value: func(result result, format formatter) string {
+			return format.throughput(result.throughput)
+		},
+	},
+	"target": {
+		header: "TARGET THROUGHPUT",
+		value: func(result result, format formatter) string {
+			if result.targetThroughput == 0 {
+				return "-"
+			}
+			return format.throughput(result.targetThroughput)
+		},
+	},
+	"mean": {
+		header: "MEAN LATENCY",
+		value: func(result result, format formatter) string {
+			return format.latency(result.meanLatency)
+		},
+	},
+	"min": {
+		header: "MIN LATENCY",
+		value: func(result result, format formatter) string {
+			return format.latency(result.minLatency)
+		},
+	},
+	"max": {
+		header: "MAX LATENCY",
+		value: func(result result, format formatter) string {
+			return format.latency(result.maxLatency)
+		},
+	},
+	"stddev": {
+		header: "STDDEV LATENCY",
+		value: func(result result, format formatter) string {
+			return format.latency(result.stdDevLatency)
+		},
+	},
+}
+
+// DefaultColumns is the columns printed in the results table if no columns are configured
+// The default columns are followed by a column for each computed latency percentile, and the target throughput
+// follows the throughput if a target rate is configured.
+var DefaultColumns = []string{"benchmark", "requests", "duration", "throughput", "mean"}
+
+// formatPercent formats the given percentile as a percentage, e.g. 99.9 for .999
+func formatPercent(percentile float32) string {
+	return strconv.FormatFloat(math.Round(float64(percentile)*1e6)/1e4, 'f', -1, 64)
+}
+
+// percentileColumnName returns the name of the column of the given latency percentile, e.g. p99.9 for .999
+func percentileColumnName(percentile float32) string {
+	return "p" + formatPercent(percentile)
+}
+
+// parsePercentileColumn returns the latency percentile named by the given column, e.g. .999 for p99.9
+func parsePercentileColumn(name string) (float32, bool) {
+	if !strings.HasPrefix(name, "p") {
+		return 0, false
+	}
+	percent, err := strconv.ParseFloat(strings.TrimPrefix(name, "p"), 64)
+	if err != nil || percent <= 0 || percent >= 100 {
+		return 0, false
+	}
+	return float32(percent / 100), true
+}
+
+// getColumn returns the named column
+func getColumn(name string) (column, bool) {
+	if column, ok := columns[name]; ok {
+		return column, true
+	}
+	if percentile, ok := parsePercentileColumn(name); ok {
+		return latencyColumn(percentile), true
+	}
+	return column{}, false
+}
+
+// ValidateColumns returns an error listing the available columns if any of the given columns is unknown
+func ValidateColumns(names []string) error {
+	for _, name := range names {
+		if _, ok := getColumn(name); !ok {
+			available := make([]string, 0, len(columns)+1)
+			for name := range columns {
+				available = append(available, name)
+			}
+			sort.Strings(available)
+			available = append(available, "p<percent> (e.g. p99.9)")
+			return fmt.Errorf("unknown column %s: available columns are %s", name, strings.Join(available, ", "))
+		}
+	}
+	return nil
+}
+
+// getColumns returns the columns configured by the given configuration, or the default columns followed by a
+// column for each computed latency percentile if no columns are configured
+// The default columns include the errors and error rate if any of the given results had errors.
+func getColumns(config *Config, results []result) []column {
+	names := config.Columns
+	if len(names) == 0 {
+		var hasErrors bool
+		for _, result := range results {
+			if result.errors > 0 {
+				hasErrors = true
+			}
+		}
+		percentiles := config.getPercentiles()
+		names = make([]string, 0, len(DefaultColumns)+len(percentiles)+3)
+		for _, name := range DefaultColumns {
+			names = append(names, name)
+			if name == "requests" && hasErrors {
+				names = append(names, "errors", "error-rate")
+			}
+			if name == "throughput" && config.TargetRate > 0 {
+				names = append(names, "target")
+			}
+		}
+		for _, percentile := range percentiles {
+			names = append(names, percentileColumnName(percentile))
+		}
+	}
+	selected := make([]column, 0, len(names))
+	for _, name := range names {
+		if column, ok := getColumn(name); ok {
+			selected = append(selected, column)
+		}
+	}
+	return selected
+}
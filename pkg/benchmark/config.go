@@ -17,6 +17,7 @@ package benchmark
 import (
 	"github.com/onosproject/helmit/pkg/job"
 	"os"
+	"sort"
 	"strconv"
 	"time"
 )
@@ -27,8 +28,11 @@ const (
 	benchmarkTypeEnv = "BENCHMARK_TYPE"
 	benchmarkJobType = "benchmark"
 
-	benchmarkJobEnv    = "BENCHMARK_JOB"
-	benchmarkWorkerEnv = "BENCHMARK_WORKER"
+	benchmarkJobEnv        = "BENCHMARK_JOB"
+	benchmarkWorkerEnv     = "BENCHMARK_WORKER"
+	benchmarkWorkerPortEnv = "BENCHMARK_WORKER_PORT"
+
+	workersLabel = "benchmark-workers"
 )
 
 const (
@@ -38,16 +42,133 @@ const (
 
 // Config is a benchmark configuration
 type Config struct {
-	*job.Config `json:",inline"`
-	Suite       string            `json:"suite,omitempty"`
-	Benchmark   string            `json:"benchmark,omitempty"`
-	Workers     int               `json:"workers,omitempty"`
-	Parallelism int               `json:"parallelism,omitempty"`
-	Iterations  int               `json:"iterations,omitempty"`
-	Duration    *time.Duration    `json:"duration,omitempty"`
-	Args        map[string]string `json:"args,omitempty"`
-	MaxLatency  *time.Duration    `json:"maxLatency,omitempty"`
-	NoTeardown  bool              `json:"verbose,omitempty"`
+	*job.Config        `json:",inline"`
+	WorkerImage        string                    `json:"workerImage,omitempty"`
+	Suite              string                    `json:"suite,omitempty"`
+	Benchmark          string                    `json:"benchmark,omitempty"`
+	Workers            int                       `json:"workers,omitempty"`
+	Parallelism        int                       `json:"parallelism,omitempty"`
+	Iterations         int                       `json:"iterations,omitempty"`
+	Duration           *time.Duration            `json:"duration,omitempty"`
+	Args               map[string]string         `json:"args,omitempty"`
+	MaxLatency         *time.Duration            `json:"maxLatency,omitempty"`
+	MaxLatencyWindow   *time.Duration            `json:"maxLatencyWindow,omitempty"`
+	NoTeardown         bool                      `json:"verbose,omitempty"`
+	Scrape             []ScrapeTarget            `json:"scrape,omitempty"`
+	ScrapeMetrics      []string                  `json:"scrapeMetrics,omitempty"`
+	KeepaliveTime      time.Duration             `json:"keepaliveTime,omitempty"`
+	KeepaliveTimeout   time.Duration             `json:"keepaliveTimeout,omitempty"`
+	Raw                bool                      `json:"raw,omitempty"`
+	Format             string                    `json:"format,omitempty"`
+	ReportFile         string                    `json:"reportFile,omitempty"`
+	Columns            []string                  `json:"columns,omitempty"`
+	Window             *time.Duration            `json:"window,omitempty"`
+	NoSetup            bool                      `json:"noSetup,omitempty"`
+	WorkerArgs         map[int]map[string]string `json:"workerArgs,omitempty"`
+	CheckpointInterval time.Duration             `json:"checkpointInterval,omitempty"`
+	Resume             string                    `json:"resume,omitempty"`
+	HeadlessWorkers    bool                      `json:"headlessWorkers,omitempty"`
+	HistogramPrecision int                       `json:"histogramPrecision,omitempty"`
+	HistogramMax       *time.Duration            `json:"histogramMax,omitempty"`
+	MetricsPort        int                       `json:"metricsPort,omitempty"`
+	MetricsLinger      time.Duration             `json:"metricsLinger,omitempty"`
+	WorkerBatchSize    int                       `json:"workerBatchSize,omitempty"`
+	WorkerBatchDelay   time.Duration             `json:"workerBatchDelay,omitempty"`
+	MinRequests        int                       `json:"minRequests,omitempty"`
+	MaxErrorRate       *float64                  `json:"maxErrorRate,omitempty"`
+	TargetRate         int                       `json:"targetRate,omitempty"`
+	Warmup             int                       `json:"warmup,omitempty"`
+	ContinueOnError    bool                      `json:"continueOnError,omitempty"`
+	WorkerResults      bool                      `json:"workerResults,omitempty"`
+	WorkerPort         int                       `json:"workerPort,omitempty"`
+	Percentiles        []float32                 `json:"percentiles,omitempty"`
+	NoProgress         bool                      `json:"noProgress,omitempty"`
+}
+
+const (
+	// FormatText is the format in which benchmark results are printed as a table
+	FormatText = "text"
+	// FormatJSON is the format in which benchmark results are printed as a JSON report
+	FormatJSON = "json"
+)
+
+const (
+	defaultKeepaliveTime    = 30 * time.Second
+	defaultKeepaliveTimeout = 10 * time.Second
+)
+
+// getKeepaliveTime returns the interval at which idle worker connections are pinged
+func (c *Config) getKeepaliveTime() time.Duration {
+	if c.KeepaliveTime > 0 {
+		return c.KeepaliveTime
+	}
+	return defaultKeepaliveTime
+}
+
+// getKeepaliveTimeout returns the time to wait for a keepalive ping to be acknowledged
+func (c *Config) getKeepaliveTimeout() time.Duration {
+	if c.KeepaliveTimeout > 0 {
+		return c.KeepaliveTimeout
+	}
+	return defaultKeepaliveTimeout
+}
+
+// getWorkerPort returns the port on which workers serve benchmark requests
+func (c *Config) getWorkerPort() int {
+	if c.WorkerPort > 0 {
+		return c.WorkerPort
+	}
+	return job.DefaultPort
+}
+
+// DefaultPercentiles is the latency percentiles computed if no percentiles are configured
+var DefaultPercentiles = []float32{.5, .75, .95, .99}
+
+// getPercentiles returns the latency percentiles to compute in ascending order
+// Percentiles named by the configured columns are computed even if they're not configured.
+func (c *Config) getPercentiles() []float32 {
+	configured := c.Percentiles
+	if len(configured) == 0 {
+		configured = DefaultPercentiles
+	}
+	percentiles := make([]float32, 0, len(configured))
+	add := func(percentile float32) {
+		for _, p := range percentiles {
+			if samePercentile(p, percentile) {
+				return
+			}
+		}
+		percentiles = append(percentiles, percentile)
+	}
+	for _, percentile := range configured {
+		add(percentile)
+	}
+	for _, name := range c.Columns {
+		if percentile, ok := parsePercentileColumn(name); ok {
+			add(percentile)
+		}
+	}
+	sort.Slice(percentiles, func(i, j int) bool {
+		return percentiles[i] < percentiles[j]
+	})
+	return percentiles
+}
+
+// getWorkerArgs returns the benchmark arguments for the given worker
+// Arguments specified for the worker override the arguments shared by all workers.
+func (c *Config) getWorkerArgs(worker int) map[string]string {
+	workerArgs, ok := c.WorkerArgs[worker]
+	if !ok {
+		return c.Args
+	}
+	args := make(map[string]string)
+	for key, value := range c.Args {
+		args[key] = value
+	}
+	for key, value := range workerArgs {
+		args[key] = value
+	}
+	return args
 }
 
 // getBenchmarkType returns the current benchmark type
@@ -71,3 +192,16 @@ func getBenchmarkWorker() int {
 	}
 	return i
 }
+
+// getBenchmarkWorkerPort returns the port on which the current benchmark worker serves requests
+func getBenchmarkWorkerPort() int {
+	port := os.Getenv(benchmarkWorkerPortEnv)
+	if port == "" {
+		return job.DefaultPort
+	}
+	i, err := strconv.Atoi(port)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
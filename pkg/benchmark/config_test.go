@@ -0,0 +1,74 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetPercentiles(t *testing.T) {
+	tests := []struct {
+		name        string
+		percentiles []float32
+		columns     []string
+		expected    []float32
+	}{
+		{
+			name:     "default percentiles",
+			expected: DefaultPercentiles,
+		},
+		{
+			name:        "configured percentiles are sorted",
+			percentiles: []float32{.99, .5, .9},
+			expected:    []float32{.5, .9, .99},
+		},
+		{
+			name:        "duplicate percentiles are removed",
+			percentiles: []float32{.5, .5, .99},
+			expected:    []float32{.5, .99},
+		},
+		{
+			name:        "percentile columns are added",
+			percentiles: []float32{.5},
+			columns:     []string{"requests", "p99.9", "p50"},
+			expected:    []float32{.5, .999},
+		},
+		{
+			name:     "percentile columns are added to the defaults",
+			columns:  []string{"p90"},
+			expected: []float32{.5, .75, .9, .95, .99},
+		},
+		{
+			name:        "invalid percentile columns are ignored",
+			percentiles: []float32{.5},
+			columns:     []string{"p0", "p100", "pmax"},
+			expected:    []float32{.5},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{
+				Percentiles: test.percentiles,
+				Columns:     test.columns,
+			}
+			percentiles := config.getPercentiles()
+			assert.Len(t, percentiles, len(test.expected))
+			for i := range test.expected {
+				assert.True(t, samePercentile(test.expected[i], percentiles[i]), "expected %v, got %v", test.expected, percentiles)
+			}
+		})
+	}
+}
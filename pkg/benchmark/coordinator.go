@@ -19,8 +19,11 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"os/signal"
+	"sort"
+	"strings"
 	"sync"
-	"text/tabwriter"
+	"syscall"
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
@@ -32,8 +35,25 @@ import (
 	"github.com/onosproject/helmit/pkg/util/logging"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
 )
 
+// progressInterval is the interval at which the coordinator polls workers for benchmark progress
+const progressInterval = time.Second
+
+// progressPercentile is the latency percentile reported in the progress of running benchmarks
+const progressPercentile = .99
+
+// maxTimeoutMargin is the maximum time before the job timeout at which running benchmarks are stopped
+// to leave time to report their partial results
+const maxTimeoutMargin = 30 * time.Second
+
+// workerLogLines is the number of lines of a worker's output included in errors for failed worker requests
+const workerLogLines = 100
+
+// latencySkewFactor is the ratio of the maximum to minimum worker latency at which workers are considered to disagree
+const latencySkewFactor = 2.0
+
 // newCoordinator returns a new benchmark coordinator
 func newCoordinator(config *Config) (*Coordinator, error) {
 	return &Coordinator{
@@ -56,6 +76,33 @@ func (c *Coordinator) Run() (int, error) {
 	} else {
 		suites = []string{c.config.Suite}
 	}
+	if err := validateBenchmarks(suites, c.config.Benchmark); err != nil {
+		return 1, err
+	}
+
+	// Checkpoints are stored under the ID of the resumed run so that a run can be resumed more than once
+	checkpointID := c.config.ID
+	if c.config.Resume != "" {
+		checkpointID = c.config.Resume
+	}
+	checkpoints := &checkpointStore{
+		runner: c.runner,
+		id:     checkpointID,
+	}
+
+	// Stop running benchmarks and report their partial results before the job times out
+	ctx, cancel := newRunContext(c.config.Config.Timeout)
+	defer cancel()
+
+	// Serve the benchmark metrics for scraping if a metrics port is configured
+	var exporter *metricsExporter
+	if c.config.MetricsPort > 0 {
+		exporter = newMetricsExporter(c.config.MetricsPort)
+		if err := exporter.start(); err != nil {
+			return 1, err
+		}
+		defer exporter.stop(c.config.MetricsLinger)
+	}
 
 	var returnCode int
 	for _, suite := range suites {
@@ -72,25 +119,61 @@ func (c *Coordinator) Run() (int, error) {
 				Executable:      c.config.Config.Executable,
 				Context:         c.config.Config.Context,
 				Values:          c.config.Config.Values,
+				StringValues:    c.config.Config.StringValues,
 				ValueFiles:      c.config.Config.ValueFiles,
 				Env:             c.config.Config.Env,
 				Timeout:         c.config.Config.Timeout,
 				NoTeardown:      c.config.Config.NoTeardown,
 				Secrets:         c.config.Config.Secrets,
+				WaitContainers:  c.config.Config.WaitContainers,
 			},
-			Suite:       suite,
-			Benchmark:   c.config.Benchmark,
-			Workers:     c.config.Workers,
-			Parallelism: c.config.Parallelism,
-			Iterations:  c.config.Iterations,
-			Duration:    c.config.Duration,
-			MaxLatency:  c.config.MaxLatency,
-			Args:        c.config.Args,
-			NoTeardown:  c.config.Config.NoTeardown,
+			WorkerImage:        c.config.WorkerImage,
+			Suite:              suite,
+			Benchmark:          c.config.Benchmark,
+			Workers:            c.config.Workers,
+			Parallelism:        c.config.Parallelism,
+			Iterations:         c.config.Iterations,
+			Duration:           c.config.Duration,
+			MaxLatency:         c.config.MaxLatency,
+			MaxLatencyWindow:   c.config.MaxLatencyWindow,
+			Args:               c.config.Args,
+			NoTeardown:         c.config.Config.NoTeardown,
+			Scrape:             c.config.Scrape,
+			ScrapeMetrics:      c.config.ScrapeMetrics,
+			KeepaliveTime:      c.config.KeepaliveTime,
+			KeepaliveTimeout:   c.config.KeepaliveTimeout,
+			Raw:                c.config.Raw,
+			Format:             c.config.Format,
+			ReportFile:         c.config.ReportFile,
+			Columns:            c.config.Columns,
+			Window:             c.config.Window,
+			NoSetup:            c.config.NoSetup,
+			WorkerArgs:         c.config.WorkerArgs,
+			CheckpointInterval: c.config.CheckpointInterval,
+			Resume:             c.config.Resume,
+			HeadlessWorkers:    c.config.HeadlessWorkers,
+			HistogramPrecision: c.config.HistogramPrecision,
+			HistogramMax:       c.config.HistogramMax,
+			Percentiles:        c.config.Percentiles,
+			NoProgress:         c.config.NoProgress,
+			MetricsPort:        c.config.MetricsPort,
+			MetricsLinger:      c.config.MetricsLinger,
+			WorkerBatchSize:    c.config.WorkerBatchSize,
+			WorkerBatchDelay:   c.config.WorkerBatchDelay,
+			MinRequests:        c.config.MinRequests,
+			MaxErrorRate:       c.config.MaxErrorRate,
+			TargetRate:         c.config.TargetRate,
+			Warmup:             c.config.Warmup,
+			ContinueOnError:    c.config.ContinueOnError,
+			WorkerResults:      c.config.WorkerResults,
+			WorkerPort:         c.config.WorkerPort,
 		}
 		task := &WorkerTask{
-			runner: c.runner,
-			config: config,
+			ctx:         ctx,
+			runner:      c.runner,
+			config:      config,
+			checkpoints: checkpoints,
+			exporter:    exporter,
 		}
 		status, err := task.Run()
 		if err != nil {
@@ -99,9 +182,68 @@ func (c *Coordinator) Run() (int, error) {
 			returnCode = status
 		}
 	}
+
+	// Once all the benchmarks have completed, the checkpoints are no longer needed
+	if c.config.CheckpointInterval > 0 || c.config.Resume != "" {
+		if err := checkpoints.delete(); err != nil {
+			return 1, err
+		}
+	}
 	return returnCode, nil
 }
 
+// newRunContext returns a context that's done shortly before the given job timeout elapses or once the
+// coordinator is signaled to terminate
+func newRunContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		margin := timeout / 10
+		if margin > maxTimeoutMargin {
+			margin = maxTimeoutMargin
+		}
+		ctx, cancel = context.WithTimeout(context.Background(), timeout-margin)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-signalCh:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(signalCh)
+	}()
+	return ctx, cancel
+}
+
+// validateBenchmarks returns an error listing the available names if the requested suites or benchmark are not registered
+func validateBenchmarks(suites []string, benchmark string) error {
+	available := registry.GetBenchmarkSuites()
+	for _, suite := range suites {
+		if registry.GetBenchmarkSuite(suite) == nil {
+			return fmt.Errorf("unknown benchmark suite %s: available suites are %s", suite, strings.Join(available, ", "))
+		}
+	}
+	if benchmark == "" {
+		return nil
+	}
+
+	var benchmarks []string
+	for _, suite := range suites {
+		for _, name := range getBenchmarks(registry.GetBenchmarkSuite(suite)) {
+			if name == benchmark {
+				return nil
+			}
+			benchmarks = append(benchmarks, name)
+		}
+	}
+	return fmt.Errorf("unknown benchmark %s: available benchmarks are %s", benchmark, strings.Join(benchmarks, ", "))
+}
+
 // newJobID returns a new unique test job ID
 func newJobID(testID, suite string) string {
 	return fmt.Sprintf("%s-%s", testID, suite)
@@ -109,9 +251,12 @@ func newJobID(testID, suite string) string {
 
 // WorkerTask manages a single test job for a test worker
 type WorkerTask struct {
-	runner  *job.Runner
-	config  *Config
-	workers []WorkerServiceClient
+	ctx         context.Context
+	runner      *job.Runner
+	config      *Config
+	workers     []WorkerServiceClient
+	checkpoints *checkpointStore
+	exporter    *metricsExporter
 }
 
 // Run runs the worker job
@@ -126,23 +271,116 @@ func (t *WorkerTask) run() error {
 	if err := t.createWorkers(); err != nil {
 		return err
 	}
-	if err := t.runBenchmarks(); err != nil {
-		return err
+	err := t.runBenchmarks()
+	t.shutdownWorkers()
+	if t.config.HeadlessWorkers {
+		_ = t.runner.DeleteService(getWorkersServiceName(t.config.ID))
 	}
-	return nil
+	return err
 }
 
 func getWorkerName(worker int, jobID string) string {
 	return fmt.Sprintf("%s-worker-%d", jobID, worker)
 }
 
+// getWorkersServiceName returns the name of the headless service shared by the workers of the given job
+func getWorkersServiceName(jobID string) string {
+	return fmt.Sprintf("%s-workers", jobID)
+}
+
 func (t *WorkerTask) getWorkerAddress(worker int) string {
-	return fmt.Sprintf("%s:5000", getWorkerName(worker, t.config.ID))
+	if t.config.HeadlessWorkers {
+		return fmt.Sprintf("%s.%s:%d", getWorkerName(worker, t.config.ID), getWorkersServiceName(t.config.ID), t.config.getWorkerPort())
+	}
+	return fmt.Sprintf("%s:%d", getWorkerName(worker, t.config.ID), t.config.getWorkerPort())
+}
+
+// getWorkerLabels returns the labels for the benchmark workers
+// Headless workers are labeled with the job ID to be selected by the shared workers service.
+func (t *WorkerTask) getWorkerLabels() map[string]string {
+	labels := make(map[string]string)
+	for key, value := range t.config.Config.Labels {
+		labels[key] = value
+	}
+	if t.config.HeadlessWorkers {
+		labels[workersLabel] = t.config.ID
+	}
+	return labels
 }
 
 // createWorkers creates the benchmark workers
+// Workers are created in batches of the configured batch size, waiting for each batch to start running before
+// the next batch is created. If any worker fails to start, all workers are deleted to avoid orphaning the workers
+// that were created.
 func (t *WorkerTask) createWorkers() error {
-	return async.IterAsync(t.config.Workers, t.createWorker)
+	// Headless workers are addressed through a single service in place of a service per worker
+	if t.config.HeadlessWorkers {
+		selector := map[string]string{
+			workersLabel: t.config.ID,
+		}
+		if err := t.runner.CreateHeadlessService(getWorkersServiceName(t.config.ID), selector, t.config.getWorkerPort()); err != nil {
+			return fmt.Errorf("failed to create workers service: %v", err)
+		}
+	}
+
+	batchSize := t.config.WorkerBatchSize
+	if batchSize <= 0 || batchSize > t.config.Workers {
+		batchSize = t.config.Workers
+	}
+
+	for start := 0; start < t.config.Workers; start += batchSize {
+		end := start + batchSize
+		if end > t.config.Workers {
+			end = t.config.Workers
+		}
+
+		// Wait before creating each batch after the first to spread out image pulls and scheduling
+		if start > 0 && t.config.WorkerBatchDelay > 0 {
+			time.Sleep(t.config.WorkerBatchDelay)
+		}
+		if err := t.createWorkerBatch(start, end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createWorkerBatch creates the workers in the range [start, end) and waits for them to start running
+func (t *WorkerTask) createWorkerBatch(start, end int) error {
+	errs := make([]error, end-start)
+	wg := &sync.WaitGroup{}
+	for i := start; i < end; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			errs[worker-start] = t.createWorker(worker)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.deleteWorkers()
+			return fmt.Errorf("failed to create worker %d: %v", start+i, err)
+		}
+	}
+	return nil
+}
+
+// deleteWorkers deletes all the benchmark workers
+func (t *WorkerTask) deleteWorkers() {
+	_ = async.IterAsync(t.config.Workers, func(worker int) error {
+		// Ignore errors to ensure the deletion of every worker is attempted
+		_ = t.runner.DeleteJob(&job.Job{
+			Config: &job.Config{
+				ID: getWorkerName(worker, t.config.ID),
+			},
+		})
+		return nil
+	})
+	if t.config.HeadlessWorkers {
+		_ = t.runner.DeleteService(getWorkersServiceName(t.config.ID))
+	}
 }
 
 // createWorker creates the given worker
@@ -155,24 +393,41 @@ func (t *WorkerTask) createWorker(worker int) error {
 	env[config.NamespaceEnv] = t.config.ID
 	env[benchmarkTypeEnv] = string(benchmarkTypeWorker)
 	env[benchmarkWorkerEnv] = fmt.Sprintf("%d", worker)
+	env[benchmarkWorkerPortEnv] = fmt.Sprintf("%d", t.config.getWorkerPort())
 	env[benchmarkJobEnv] = t.config.ID
+
+	// Workers may run a separate image that shares the coordinator's executable contract
+	image := t.config.WorkerImage
+	if image == "" {
+		image = t.config.Config.Image
+	}
+
+	var subdomain string
+	if t.config.HeadlessWorkers {
+		subdomain = getWorkersServiceName(t.config.ID)
+	}
+
 	job := &job.Job{
 		Config: &job.Config{
 			ID:              jobID,
 			Namespace:       t.config.Config.Namespace,
 			ServiceAccount:  t.config.Config.ServiceAccount,
-			Labels:          t.config.Config.Labels,
+			Labels:          t.getWorkerLabels(),
 			Annotations:     t.config.Config.Annotations,
-			Image:           t.config.Config.Image,
+			Image:           image,
 			ImagePullPolicy: t.config.Config.ImagePullPolicy,
 			Executable:      t.config.Config.Executable,
 			Context:         t.config.Config.Context,
 			Values:          t.config.Config.Values,
+			StringValues:    t.config.Config.StringValues,
 			ValueFiles:      t.config.Config.ValueFiles,
 			Env:             env,
 			Timeout:         t.config.Config.Timeout,
 			NoTeardown:      t.config.Config.NoTeardown,
 			Secrets:         t.config.Config.Secrets,
+			Subdomain:       subdomain,
+			WaitContainers:  t.config.Config.WaitContainers,
+			Port:            t.config.getWorkerPort(),
 		},
 		JobConfig: &Config{
 			Config: &job.Config{
@@ -181,26 +436,31 @@ func (t *WorkerTask) createWorker(worker int) error {
 				ServiceAccount:  t.config.Config.ServiceAccount,
 				Labels:          t.config.Config.Labels,
 				Annotations:     t.config.Config.Annotations,
-				Image:           t.config.Config.Image,
+				Image:           image,
 				ImagePullPolicy: t.config.Config.ImagePullPolicy,
 				Executable:      t.config.Config.Executable,
 				Context:         t.config.Config.Context,
 				Values:          t.config.Config.Values,
+				StringValues:    t.config.Config.StringValues,
 				ValueFiles:      t.config.Config.ValueFiles,
 				Env:             env,
 				Timeout:         t.config.Config.Timeout,
 				NoTeardown:      t.config.Config.NoTeardown,
 				Secrets:         t.config.Config.Secrets,
+				WaitContainers:  t.config.Config.WaitContainers,
 			},
-			Suite:       t.config.Suite,
-			Benchmark:   t.config.Benchmark,
-			Workers:     t.config.Workers,
-			Parallelism: t.config.Parallelism,
-			Iterations:  t.config.Iterations,
-			Duration:    t.config.Duration,
-			MaxLatency:  t.config.MaxLatency,
-			Args:        t.config.Args,
-			NoTeardown:  t.config.Config.NoTeardown,
+			Suite:            t.config.Suite,
+			Benchmark:        t.config.Benchmark,
+			Workers:          t.config.Workers,
+			Parallelism:      t.config.Parallelism,
+			Iterations:       t.config.Iterations,
+			Duration:         t.config.Duration,
+			MaxLatency:       t.config.MaxLatency,
+			MaxLatencyWindow: t.config.MaxLatencyWindow,
+			Args:             t.config.Args,
+			NoTeardown:       t.config.Config.NoTeardown,
+			KeepaliveTime:    t.config.KeepaliveTime,
+			KeepaliveTimeout: t.config.KeepaliveTimeout,
 		},
 		Type: benchmarkJobType,
 	}
@@ -218,6 +478,11 @@ func (t *WorkerTask) getWorkers() ([]WorkerServiceClient, error) {
 		worker, err := grpc.Dial(
 			t.getWorkerAddress(i),
 			grpc.WithInsecure(),
+			grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:                t.config.getKeepaliveTime(),
+				Timeout:             t.config.getKeepaliveTimeout(),
+				PermitWithoutStream: true,
+			}),
 			grpc.WithUnaryInterceptor(
 				grpc_retry.UnaryClientInterceptor(
 					grpc_retry.WithCodes(codes.Unavailable),
@@ -241,10 +506,15 @@ func (t *WorkerTask) setupSuite() error {
 
 	worker := workers[0]
 	_, err = worker.SetupSuite(context.Background(), &SuiteRequest{
-		Suite: t.config.Suite,
-		Args:  t.config.Args,
+		Suite:   t.config.Suite,
+		Args:    t.config.getWorkerArgs(0),
+		Worker:  0,
+		Workers: uint32(len(workers)),
 	})
-	return err
+	if err != nil {
+		return t.workerFailed("SetupSuite", 0, err)
+	}
+	return nil
 }
 
 // setupWorkers sets up the benchmark workers
@@ -255,27 +525,90 @@ func (t *WorkerTask) setupWorkers() error {
 	}
 
 	wg := &sync.WaitGroup{}
-	errCh := make(chan error)
-	for _, worker := range workers {
+	errCh := make(chan error, len(workers))
+	for i, worker := range workers {
 		wg.Add(1)
-		go func(worker WorkerServiceClient) {
-			_, err = worker.SetupWorker(context.Background(), &SuiteRequest{
-				Suite: t.config.Suite,
-				Args:  t.config.Args,
+		go func(i int, worker WorkerServiceClient) {
+			_, err := worker.SetupWorker(context.Background(), &SuiteRequest{
+				Suite:   t.config.Suite,
+				Args:    t.config.getWorkerArgs(i),
+				Worker:  uint32(i),
+				Workers: uint32(len(workers)),
 			})
 			if err != nil {
-				errCh <- err
+				errCh <- t.workerFailed("SetupWorker", i, err)
 			}
 			wg.Done()
-		}(worker)
+		}(i, worker)
 	}
 	wg.Wait()
 	close(errCh)
+	return t.getWorkerErrors("", len(workers), errCh)
+}
 
+// getWorkerErrors returns the first error received from the given closed channel, or a WorkersFailed error
+// with all the errors if errors are collected from all workers
+func (t *WorkerTask) getWorkerErrors(benchmark string, workers int, errCh <-chan error) error {
+	var errs []*WorkerFailed
 	for err := range errCh {
-		return err
+		workerErr, ok := err.(*WorkerFailed)
+		if !t.config.ContinueOnError || !ok {
+			return err
+		}
+		errs = append(errs, workerErr)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Worker < errs[j].Worker
+	})
+	return &WorkersFailed{
+		Benchmark: benchmark,
+		Workers:   workers,
+		Errors:    errs,
+	}
+}
+
+// workerFailed returns a WorkerFailed error for a request that failed on the given worker
+// The tail of the worker's output is included in the error to explain the failure, e.g. with a stack trace if
+// the worker crashed.
+func (t *WorkerTask) workerFailed(operation string, worker int, err error) error {
+	logs, logsErr := t.runner.GetLogs(&job.Job{Config: &job.Config{ID: getWorkerName(worker, t.config.ID)}}, workerLogLines)
+	if logsErr != nil {
+		logs = ""
+	}
+	return &WorkerFailed{
+		Operation: operation,
+		Worker:    worker,
+		Err:       err,
+		Logs:      logs,
 	}
-	return nil
+}
+
+// shutdownWorkers requests that the workers tear down the suite and exit
+// Errors are ignored since the workers' namespace is deleted once the job completes.
+func (t *WorkerTask) shutdownWorkers() {
+	workers, err := t.getWorkers()
+	if err != nil {
+		return
+	}
+
+	wg := &sync.WaitGroup{}
+	for i, worker := range workers {
+		wg.Add(1)
+		go func(i int, worker WorkerServiceClient) {
+			_, _ = worker.Shutdown(context.Background(), &ShutdownRequest{
+				Suite:         t.config.Suite,
+				Args:          t.config.getWorkerArgs(i),
+				TearDownSuite: i == 0 && !t.config.NoSetup,
+				Worker:        uint32(i),
+				Workers:       uint32(len(workers)),
+			})
+			wg.Done()
+		}(i, worker)
+	}
+	wg.Wait()
 }
 
 // setupBenchmark sets up the given benchmark
@@ -286,35 +619,35 @@ func (t *WorkerTask) setupBenchmark(benchmark string) error {
 	}
 
 	wg := &sync.WaitGroup{}
-	errCh := make(chan error)
-	for _, worker := range workers {
+	errCh := make(chan error, len(workers))
+	for i, worker := range workers {
 		wg.Add(1)
-		go func(worker WorkerServiceClient) {
-			_, err = worker.SetupBenchmark(context.Background(), &BenchmarkRequest{
+		go func(i int, worker WorkerServiceClient) {
+			_, err := worker.SetupBenchmark(context.Background(), &BenchmarkRequest{
 				Suite:     t.config.Suite,
 				Benchmark: benchmark,
-				Args:      t.config.Args,
+				Args:      t.config.getWorkerArgs(i),
+				Worker:    uint32(i),
+				Workers:   uint32(len(workers)),
 			})
 			if err != nil {
-				errCh <- err
+				errCh <- t.workerFailed("SetupBenchmark", i, err)
 			}
 			wg.Done()
-		}(worker)
+		}(i, worker)
 	}
 	wg.Wait()
 	close(errCh)
-
-	for err := range errCh {
-		return err
-	}
-	return nil
+	return t.getWorkerErrors(benchmark, len(workers), errCh)
 }
 
 // runBenchmarks runs the given benchmarks
 func (t *WorkerTask) runBenchmarks() error {
-	// Setup the benchmark suite on one of the workers
-	if err := t.setupSuite(); err != nil {
-		return err
+	// Setup the benchmark suite on one of the workers unless the system under test is externally managed
+	if !t.config.NoSetup {
+		if err := t.setupSuite(); err != nil {
+			return err
+		}
 	}
 
 	// Setup the workers
@@ -323,7 +656,10 @@ func (t *WorkerTask) runBenchmarks() error {
 	}
 
 	// Run the benchmarks
+	// If errors are collected from all workers, benchmarks that failed on every worker are reported once the
+	// remaining benchmarks have completed.
 	results := make([]result, 0)
+	var workersErrs []error
 	if t.config.Benchmark != "" {
 		step := logging.NewStep(t.config.ID, "Run benchmark %s", t.config.Benchmark)
 		step.Start()
@@ -332,7 +668,11 @@ func (t *WorkerTask) runBenchmarks() error {
 			step.Fail(err)
 			return err
 		}
-		step.Complete()
+		if result.workersErr != nil {
+			step.Fail(result.workersErr)
+		} else {
+			step.Complete()
+		}
 		results = append(results, result)
 	} else {
 		suiteStep := logging.NewStep(t.config.ID, "Run benchmark suite %s", t.config.Suite)
@@ -343,32 +683,255 @@ func (t *WorkerTask) runBenchmarks() error {
 			benchmarkSuite := logging.NewStep(t.config.ID, "Run benchmark %s", benchmark)
 			benchmarkSuite.Start()
 			result, err := t.runBenchmark(benchmark)
-			if err != nil {
+			if err != nil && IsWorkersFailed(err) {
+				benchmarkSuite.Fail(err)
+				workersErrs = append(workersErrs, err)
+				continue
+			} else if err != nil {
 				benchmarkSuite.Fail(err)
 				suiteStep.Fail(err)
 				return err
 			}
-			benchmarkSuite.Complete()
+			if result.workersErr != nil {
+				benchmarkSuite.Fail(result.workersErr)
+			} else {
+				benchmarkSuite.Complete()
+			}
 			results = append(results, result)
+
+			// Stop running benchmarks once the job is about to time out
+			if result.incomplete {
+				break
+			}
+		}
+		if len(workersErrs) > 0 {
+			suiteStep.Fail(workersErrs[0])
+		} else {
+			suiteStep.Complete()
+		}
+	}
+
+	if t.config.Format == FormatJSON {
+		if err := printReport(t.config.Suite, results); err != nil {
+			return err
+		}
+	} else {
+		printResults(results, getColumns(t.config, results), formatter{raw: t.config.Raw})
+	}
+	if t.config.ReportFile != "" {
+		if err := writeReport(t.config.Suite, results); err != nil {
+			return err
 		}
-		suiteStep.Complete()
 	}
 
-	writer := new(tabwriter.Writer)
-	writer.Init(os.Stdout, 0, 0, 3, ' ', tabwriter.FilterHTML)
-	fmt.Fprintln(writer, "BENCHMARK\tREQUESTS\tDURATION\tTHROUGHPUT\tMEAN LATENCY\tMEDIAN LATENCY\t75% LATENCY\t95% LATENCY\t99% LATENCY")
 	for _, result := range results {
-		fmt.Fprintf(writer, "%s\t%d\t%s\t%f/sec\t%s\t%s\t%s\t%s\t%s\n",
-			result.benchmark, result.requests, result.duration, result.throughput, result.meanLatency,
-			result.latencyPercentiles[.5], result.latencyPercentiles[.75],
-			result.latencyPercentiles[.95], result.latencyPercentiles[.99])
+		if result.workersErr != nil {
+			workersErrs = append(workersErrs, result.workersErr)
+		}
+	}
+	if len(workersErrs) > 0 {
+		return workersErrs[0]
+	}
+
+	for _, result := range results {
+		if result.incomplete {
+			return &TimedOut{
+				Benchmark: result.benchmark,
+				Timeout:   t.config.Config.Timeout,
+			}
+		}
+	}
+
+	return checkResults(results, t.config)
+}
+
+// checkResults returns an error if any of the given benchmark results failed verification or did not meet
+// the configured minimum requests, maximum error rate, or maximum latency
+// The results are checked the same way whether the benchmarks were run by workers or in-process.
+func checkResults(results []result, config *Config) error {
+	for _, result := range results {
+		if result.verifyErr != nil {
+			return result.verifyErr
+		}
+	}
+
+	for _, result := range results {
+		if err := checkRequests(result, config.MinRequests); err != nil {
+			return err
+		}
+	}
+
+	for _, result := range results {
+		if err := checkErrorRate(result, config.MaxErrorRate); err != nil {
+			return err
+		}
+	}
+
+	for _, result := range results {
+		if err := checkMaxLatency(result, config.MaxLatency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkMaxLatency returns an error if the given benchmark's mean latency reaches the maximum, if configured
+func checkMaxLatency(result result, maxLatency *time.Duration) error {
+	if maxLatency != nil && result.meanLatency >= *maxLatency {
+		return &MaxLatencyExceeded{
+			Benchmark:  result.benchmark,
+			Latency:    result.meanLatency,
+			MaxLatency: *maxLatency,
+		}
+	}
+	return nil
+}
+
+// checkErrorRate returns an error if the given benchmark's error rate exceeds the maximum, if configured
+func checkErrorRate(result result, maxErrorRate *float64) error {
+	if maxErrorRate == nil || result.requests == 0 {
+		return nil
+	}
+	if float64(result.errors)/float64(result.requests) > *maxErrorRate {
+		return &ErrorRateExceeded{
+			Benchmark:    result.benchmark,
+			Requests:     result.requests,
+			Errors:       result.errors,
+			MaxErrorRate: *maxErrorRate,
+		}
+	}
+	return nil
+}
+
+// stopWorkers stops the given benchmark on all the given workers
+func (t *WorkerTask) stopWorkers(benchmark string, workers []WorkerServiceClient) {
+	for _, worker := range workers {
+		_, _ = worker.StopBenchmark(context.Background(), &StopRequest{
+			Suite:     t.config.Suite,
+			Benchmark: benchmark,
+		})
+	}
+}
+
+// checkRequests returns an error if the given benchmark completed fewer successful requests than the minimum
+// A benchmark with no successful requests always fails.
+func checkRequests(result result, minRequests int) error {
+	successful := result.requests - result.errors
+	if successful <= 0 || successful < minRequests {
+		return &InsufficientRequests{
+			Benchmark:   result.benchmark,
+			Requests:    successful,
+			Errors:      result.errors,
+			MinRequests: minRequests,
+		}
+	}
+	return nil
+}
+
+// printResults prints a table of the given benchmark results with the given columns
+func printResults(results []result, columns []column, format formatter) {
+	writer := newTableWriter()
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.header
+	}
+	fmt.Fprintln(writer, strings.Join(headers, "\t"))
+	for _, result := range results {
+		values := make([]string, len(columns))
+		for i, column := range columns {
+			values[i] = column.value(result, format)
+		}
+		fmt.Fprintln(writer, strings.Join(values, "\t"))
 	}
 
 	writer.Flush()
 
 	for _, result := range results {
-		if t.config.MaxLatency != nil && result.meanLatency >= *t.config.MaxLatency {
-			return fmt.Errorf("mean latency of %d exceeds maximum of %v", result.meanLatency.Milliseconds(), t.config.MaxLatency)
+		if result.incomplete {
+			printWorkerProgress(result, format)
+		}
+	}
+
+	for _, result := range results {
+		if result.verifyErr != nil {
+			fmt.Printf("\nVERIFICATION FAILED %s: %v\n", result.benchmark, result.verifyErr)
+		}
+	}
+
+	for _, result := range results {
+		if result.workersErr != nil {
+			fmt.Printf("\nPARTIAL RESULTS %s: %v\n", result.benchmark, result.workersErr)
+		}
+	}
+
+	for _, result := range results {
+		if len(result.workerResults) > 0 {
+			printWorkerResults(result, format)
+		}
+	}
+
+	for _, result := range results {
+		if result.workers > 1 {
+			printLatencyRanges(result, format)
+		}
+	}
+
+	for _, result := range results {
+		if len(result.windows) > 0 {
+			printTimeline(result, format)
+		}
+	}
+
+	for _, result := range results {
+		if len(result.metrics) > 0 {
+			printMetrics(result)
+		}
+	}
+}
+
+// printWorkerProgress prints the number of requests completed by each worker for a benchmark stopped before it completed
+func printWorkerProgress(result result, format formatter) {
+	fmt.Printf("\nINCOMPLETE %s: stopped before the job timeout\n", result.benchmark)
+	writer := newTableWriter()
+	fmt.Fprintln(writer, "WORKER\tREQUESTS")
+	for worker, requests := range result.workerRequests {
+		fmt.Fprintf(writer, "%d\t%s\n", worker, format.count(requests))
+	}
+	writer.Flush()
+}
+
+// verifyBenchmark verifies the results of the given benchmark on all workers
+// If verification fails on any worker, a VerificationFailed error is returned for the lowest failed worker.
+func (t *WorkerTask) verifyBenchmark(benchmark string) error {
+	workers, err := t.getWorkers()
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, len(workers))
+	wg := &sync.WaitGroup{}
+	for i, worker := range workers {
+		wg.Add(1)
+		go func(i int, worker WorkerServiceClient) {
+			_, errs[i] = worker.VerifyBenchmark(context.Background(), &BenchmarkRequest{
+				Suite:     t.config.Suite,
+				Benchmark: benchmark,
+				Args:      t.config.getWorkerArgs(i),
+				Worker:    uint32(i),
+				Workers:   uint32(len(workers)),
+			})
+			wg.Done()
+		}(i, worker)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return &VerificationFailed{
+				Benchmark: benchmark,
+				Worker:    i,
+				Err:       err,
+			}
 		}
 	}
 	return nil
@@ -377,6 +940,36 @@ func (t *WorkerTask) runBenchmarks() error {
 // runBenchmark runs the given benchmark
 func (t *WorkerTask) runBenchmark(benchmark string) (result, error) {
 	// Setup the benchmark
+	// Load the progress of the benchmark from a prior run if resuming
+	var prior *checkpoint
+	iterations := t.config.Iterations
+	duration := t.config.Duration
+	if t.config.Resume != "" {
+		var err error
+		prior, err = t.checkpoints.load(t.config.Suite, benchmark)
+		if err != nil {
+			return result{}, err
+		}
+		if prior != nil {
+			if prior.Completed {
+				return prior.result(benchmark, t.config.Workers), nil
+			}
+			if iterations > 0 {
+				iterations -= prior.Requests
+				if iterations <= 0 {
+					return prior.result(benchmark, t.config.Workers), nil
+				}
+			}
+			if duration != nil {
+				remaining := *duration - prior.Duration
+				if remaining <= 0 {
+					return prior.result(benchmark, t.config.Workers), nil
+				}
+				duration = &remaining
+			}
+		}
+	}
+
 	if err := t.setupBenchmark(benchmark); err != nil {
 		return result{}, err
 	}
@@ -386,79 +979,604 @@ func (t *WorkerTask) runBenchmark(benchmark string) (result, error) {
 		return result{}, err
 	}
 
+	// Scrape metrics from the target pods for the duration of the benchmark
+	var metricsScraper *scraper
+	if len(t.config.Scrape) > 0 {
+		if metricsScraper, err = newScraper(t.runner, t.config.ID, t.config.Scrape, t.config.ScrapeMetrics); err != nil {
+			return result{}, err
+		}
+		metricsScraper.start()
+	}
+
 	wg := &sync.WaitGroup{}
 	resultCh := make(chan *RunResponse, len(workers))
 	errCh := make(chan error, len(workers))
 
-	for _, worker := range workers {
+	// If the benchmark is aborted on any worker, stop the benchmark on the remaining workers
+	var abortErr error
+	abortOnce := &sync.Once{}
+	abort := func(worker int, reason string) {
+		abortOnce.Do(func() {
+			abortErr = &Aborted{
+				Benchmark: benchmark,
+				Worker:    worker,
+				Reason:    reason,
+			}
+			t.stopWorkers(benchmark, workers)
+		})
+	}
+
+	workerRequests := make([]int, len(workers))
+	workerResponses := make([]*RunResponse, len(workers))
+	for i, worker := range workers {
 		wg.Add(1)
-		go func(worker WorkerServiceClient, requests int, duration *time.Duration) {
+		go func(i int, worker WorkerServiceClient, requests int, duration *time.Duration) {
 			result, err := worker.RunBenchmark(context.Background(), &RunRequest{
-				Suite:       t.config.Suite,
-				Benchmark:   benchmark,
-				Requests:    uint32(requests),
-				Duration:    duration,
-				MaxLatency:  t.config.MaxLatency,
-				Parallelism: uint32(t.config.Parallelism),
-				Args:        t.config.Args,
+				Suite:              t.config.Suite,
+				Benchmark:          benchmark,
+				Requests:           uint32(requests),
+				Duration:           duration,
+				MaxLatency:         t.config.MaxLatency,
+				Parallelism:        uint32(t.config.Parallelism),
+				Args:               t.config.getWorkerArgs(i),
+				Window:             t.config.Window,
+				Worker:             uint32(i),
+				Workers:            uint32(len(workers)),
+				HistogramPrecision: uint32(t.config.HistogramPrecision),
+				HistogramMax:       t.config.HistogramMax,
+				Percentiles:        t.config.getPercentiles(),
+				Rate:               float64(t.config.TargetRate) / float64(len(workers)),
+				Warmup:             uint32(t.config.Warmup),
 			})
 			if err != nil {
-				errCh <- err
+				errCh <- t.workerFailed("RunBenchmark", i, err)
 			} else {
+				if result.AbortReason != "" {
+					abort(i, result.AbortReason)
+				}
+				workerRequests[i] = int(result.Requests)
+				workerResponses[i] = result
 				resultCh <- result
 			}
 			wg.Done()
-		}(worker, t.config.Iterations/len(workers), t.config.Duration)
+		}(i, worker, iterations/len(workers), duration)
 	}
 
-	wg.Wait()
+	// Stop the benchmark to collect partial results if the job is about to time out
+	doneCh := make(chan struct{})
+	stoppedCh := make(chan bool, 1)
+	go func() {
+		select {
+		case <-t.ctx.Done():
+			t.stopWorkers(benchmark, workers)
+			stoppedCh <- true
+		case <-doneCh:
+			stoppedCh <- false
+		}
+	}()
+
+	// Periodically checkpoint the progress of the benchmark if a checkpoint interval is configured
+	if t.config.CheckpointInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go t.checkpointProgress(ctx, benchmark, workers, prior)
+	}
+
+	// Periodically export the progress of the benchmark if metrics are being served
+	if t.exporter != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go t.exportProgress(ctx, benchmark, workers)
+	}
+
+	// Periodically report the aggregated progress of the benchmark across workers unless disabled
+	if !t.config.NoProgress {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go t.reportProgress(ctx, benchmark, workers)
+	}
+
+	// Monitor the latency of the running benchmark if a maximum latency window is configured
+	var latencyErr error
+	if t.config.MaxLatency != nil && t.config.MaxLatencyWindow != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		monitorCh := make(chan error, 1)
+		go func() {
+			monitorCh <- t.monitorLatency(ctx, benchmark, workers)
+		}()
+		wg.Wait()
+		cancel()
+		latencyErr = <-monitorCh
+	} else {
+		wg.Wait()
+	}
+	close(doneCh)
+	incomplete := <-stoppedCh
 	close(resultCh)
 	close(errCh)
 
-	for err := range errCh {
-		return result{}, err
+	var metrics []scrapeSeries
+	if metricsScraper != nil {
+		metrics = metricsScraper.stop()
+	}
+
+	// If errors are collected from all workers, the results of the workers that succeeded are still reported
+	workersErr := t.getWorkerErrors(benchmark, len(workers), errCh)
+	if workersErr != nil && (!IsWorkersFailed(workersErr) || len(resultCh) == 0) {
+		return result{}, workersErr
+	}
+
+	if abortErr != nil {
+		return result{}, abortErr
+	}
+
+	if latencyErr != nil {
+		return result{}, latencyErr
 	}
 
-	var duration time.Duration
+	var elapsed time.Duration
 	var requests uint32
-	var latencySum time.Duration
-	var latency50Sum time.Duration
-	var latency75Sum time.Duration
-	var latency95Sum time.Duration
-	var latency99Sum time.Duration
+	var errors uint32
+	var minLatency, maxLatency time.Duration
+	var pooled pooledStdDev
+	var histogramMax time.Duration
+	if t.config.HistogramMax != nil {
+		histogramMax = *t.config.HistogramMax
+	}
+	latencyHistogram := newHistogram(t.config.HistogramPrecision, histogramMax)
+	latencyRanges := make(map[float32]latencyRange)
+	workerWindows := make([][]Window, 0, len(workers))
 	for result := range resultCh {
+		workerWindows = append(workerWindows, result.Windows)
+		for _, latency := range result.LatencyPercentiles {
+			latencyRanges[latency.Percentile] = latencyRanges[latency.Percentile].update(latency.Latency)
+		}
+		latencyHistogram.merge(result.LatencyHistogram)
 		requests += result.Requests
-		duration = time.Duration(math.Max(float64(duration), float64(result.Duration)))
-		latencySum += result.Latency
-		latency50Sum += result.Latency50
-		latency75Sum += result.Latency75
-		latency95Sum += result.Latency95
-		latency99Sum += result.Latency99
-	}
-
-	throughput := float64(requests) / (float64(duration) / float64(time.Second))
-	meanLatency := time.Duration(float64(latencySum) / float64(len(workers)))
-	latencyPercentiles := make(map[float32]time.Duration)
-	latencyPercentiles[.5] = time.Duration(float64(latency50Sum) / float64(len(workers)))
-	latencyPercentiles[.75] = time.Duration(float64(latency75Sum) / float64(len(workers)))
-	latencyPercentiles[.95] = time.Duration(float64(latency95Sum) / float64(len(workers)))
-	latencyPercentiles[.99] = time.Duration(float64(latency99Sum) / float64(len(workers)))
-
-	return result{
+		errors += result.Errors
+		elapsed = time.Duration(math.Max(float64(elapsed), float64(result.Duration)))
+		if result.Requests > 0 {
+			if minLatency == 0 || result.MinLatency < minLatency {
+				minLatency = result.MinLatency
+			}
+			if result.MaxLatency > maxLatency {
+				maxLatency = result.MaxLatency
+			}
+			pooled.add(int(result.Requests), result.Latency, result.StdDevLatency)
+		}
+	}
+
+	// Verify the results of the benchmark before they're reported unless the benchmark was stopped early
+	var verifyErr error
+	if !incomplete {
+		verifyErr = t.verifyBenchmark(benchmark)
+	}
+
+	var throughput float64
+	if elapsed > 0 {
+		throughput = float64(requests) / (float64(elapsed) / float64(time.Second))
+	}
+	succeeded := len(workerWindows)
+
+	// Percentiles are computed from the merged histograms of the workers rather than averaged, since
+	// the average of the workers' percentiles isn't a percentile of the requests of all workers
+	latencies := make([]PercentileLatency, 0, len(latencyRanges))
+	for percentile := range latencyRanges {
+		latencies = append(latencies, PercentileLatency{
+			Percentile: percentile,
+			Latency:    latencyHistogram.percentile(float64(percentile)),
+		})
+	}
+	latencyPercentiles := newLatencyPercentiles(latencies)
+
+	r := mergeResult(result{
 		benchmark:          benchmark,
+		workers:            succeeded,
 		requests:           int(requests),
-		duration:           duration,
+		errors:             int(errors),
+		duration:           elapsed,
 		throughput:         throughput,
-		meanLatency:        meanLatency,
+		targetThroughput:   float64(t.config.TargetRate),
+		meanLatency:        pooled.mean(),
+		minLatency:         minLatency,
+		maxLatency:         maxLatency,
+		stdDevLatency:      pooled.stdDev(),
 		latencyPercentiles: latencyPercentiles,
-	}, nil
+		latencyRanges:      latencyRanges,
+		metrics:            metrics,
+		window:             t.config.Window,
+		windows:            mergeWindows(workerWindows),
+		verifyErr:          verifyErr,
+		incomplete:         incomplete,
+		workerRequests:     workerRequests,
+		workersErr:         workersErr,
+		workerResults:      t.getWorkerResults(workerResponses),
+	}, prior)
+
+	if t.exporter != nil {
+		t.exporter.setResult(t.config.Suite, r)
+	}
+
+	// Record the benchmark as completed so it's skipped if the run is resumed
+	if !incomplete && workersErr == nil && (t.config.CheckpointInterval > 0 || t.config.Resume != "") {
+		if err := t.checkpoints.save(t.config.Suite, benchmark, newCheckpoint(r)); err != nil {
+			return result{}, err
+		}
+	}
+	return r, nil
+}
+
+// getWorkerResults returns the results of each worker that succeeded if per-worker results are enabled
+func (t *WorkerTask) getWorkerResults(responses []*RunResponse) []workerResult {
+	if !t.config.WorkerResults {
+		return nil
+	}
+	results := make([]workerResult, 0, len(responses))
+	for worker, response := range responses {
+		if response == nil {
+			continue
+		}
+		var throughput float64
+		if response.Duration > 0 {
+			throughput = float64(response.Requests) / response.Duration.Seconds()
+		}
+		results = append(results, workerResult{
+			worker:      worker,
+			requests:    int(response.Requests),
+			throughput:  throughput,
+			meanLatency: response.Latency,
+		})
+	}
+	return results
+}
+
+// reportProgress polls the workers for the progress of the given benchmark each progress interval and prints
+// the total requests, the combined throughput since the previous interval, and the worst 99th percentile latency
+// of any worker until the context is done
+// Progress lines are tagged with the progress stream so the CLI displays them as a single live status line.
+func (t *WorkerTask) reportProgress(ctx context.Context, benchmark string, workers []WorkerServiceClient) {
+	format := formatter{raw: t.config.Raw}
+	var lastRequests int64
+	lastTime := time.Now()
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		var requests int64
+		var worstLatency time.Duration
+		var responded int
+		for _, worker := range workers {
+			progress, err := worker.GetProgress(ctx, &ProgressRequest{
+				Suite:      t.config.Suite,
+				Benchmark:  benchmark,
+				Total:      true,
+				Percentile: progressPercentile,
+			})
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				continue
+			}
+			responded++
+			requests += int64(progress.Requests)
+			if progress.PercentileLatency > worstLatency {
+				worstLatency = progress.PercentileLatency
+			}
+		}
+
+		// The total is incomplete if any worker failed to respond, so the tick is skipped and the
+		// throughput since the last complete tick is reported by the next one
+		if responded < len(workers) {
+			continue
+		}
+
+		// Requests are not recorded while the benchmark is warming up
+		if requests == 0 {
+			fmt.Println(job.FormatOutput(job.ProgressStream, "", fmt.Sprintf("%s: warming up %d workers", benchmark, len(workers))))
+			lastTime = time.Now()
+			continue
+		}
+
+		now := time.Now()
+		throughput := float64(requests-lastRequests) / now.Sub(lastTime).Seconds()
+		lastRequests, lastTime = requests, now
+		fmt.Println(job.FormatOutput(job.ProgressStream, "", fmt.Sprintf("%s: %s requests, %s, p%s %s (worst of %d workers)",
+			benchmark, format.count(int(requests)), format.throughput(throughput), formatPercent(progressPercentile), format.latency(worstLatency), len(workers))))
+	}
+}
+
+// monitorLatency polls the workers for the progress of the given benchmark and stops the benchmark if
+// the mean latency exceeds the configured maximum for longer than the configured window
+func (t *WorkerTask) monitorLatency(ctx context.Context, benchmark string, workers []WorkerServiceClient) error {
+	start := time.Now()
+	var breachStart time.Time
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+
+		var requests uint32
+		var latencySum time.Duration
+		for _, worker := range workers {
+			progress, err := worker.GetProgress(ctx, &ProgressRequest{
+				Suite:     t.config.Suite,
+				Benchmark: benchmark,
+			})
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				continue
+			}
+			requests += progress.Requests
+			latencySum += progress.Latency * time.Duration(progress.Requests)
+		}
+
+		if requests == 0 {
+			continue
+		}
+
+		latency := latencySum / time.Duration(requests)
+		if latency < *t.config.MaxLatency {
+			breachStart = time.Time{}
+			continue
+		}
+
+		if breachStart.IsZero() {
+			breachStart = time.Now()
+		}
+		if time.Since(breachStart) >= *t.config.MaxLatencyWindow {
+			for _, worker := range workers {
+				_, _ = worker.StopBenchmark(context.Background(), &StopRequest{
+					Suite:     t.config.Suite,
+					Benchmark: benchmark,
+				})
+			}
+			return &MaxLatencyExceeded{
+				Benchmark:  benchmark,
+				Latency:    latency,
+				MaxLatency: *t.config.MaxLatency,
+				Elapsed:    time.Since(start),
+			}
+		}
+	}
+}
+
+// printWorkerResults prints the requests, throughput and mean latency of each worker for a benchmark
+func printWorkerResults(result result, format formatter) {
+	fmt.Printf("\nWORKERS %s\n", result.benchmark)
+	writer := newTableWriter()
+	fmt.Fprintln(writer, "WORKER\tREQUESTS\tTHROUGHPUT\tMEAN LATENCY")
+	for _, worker := range result.workerResults {
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", worker.worker, format.count(worker.requests), format.throughput(worker.throughput), format.latency(worker.meanLatency))
+	}
+	writer.Flush()
+}
+
+// printLatencyRanges prints the range of each latency percentile across the benchmark workers
+// Percentiles for which the workers disagreed by more than the skew factor are flagged to surface
+// unbalanced load or straggling nodes hidden by the averaged percentiles.
+func printLatencyRanges(result result, format formatter) {
+	fmt.Printf("\nLATENCY RANGES %s (%d workers)\n", result.benchmark, result.workers)
+	writer := newTableWriter()
+	fmt.Fprintln(writer, "PERCENTILE	MIN	MAX	SKEWED")
+	for _, latency := range result.latencyPercentiles {
+		latencyRange := result.latencyRanges[latency.percentile]
+		skewed := ""
+		if latencyRange.skewed() {
+			skewed = "yes"
+		}
+		fmt.Fprintf(writer, "%s%%\t%s\t%s\t%s\n", formatPercent(latency.percentile), format.latency(latencyRange.min), format.latency(latencyRange.max), skewed)
+	}
+	writer.Flush()
+}
+
+// printMetrics prints a summary of the metrics scraped while running the given benchmark
+func printMetrics(result result) {
+	fmt.Printf("\nMETRICS %s\n", result.benchmark)
+	writer := newTableWriter()
+	fmt.Fprintln(writer, "POD\tMETRIC\tSAMPLES\tFIRST\tMIN\tMAX\tLAST")
+	for _, series := range result.metrics {
+		min, max := math.Inf(1), math.Inf(-1)
+		for _, sample := range series.samples {
+			min = math.Min(min, sample.value)
+			max = math.Max(max, sample.value)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%g\t%g\t%g\t%g\n",
+			series.pod, series.metric, len(series.samples), series.samples[0].value,
+			min, max, series.samples[len(series.samples)-1].value)
+	}
+	writer.Flush()
+}
+
+// printTimeline prints the throughput and latency of each time window of the given benchmark
+func printTimeline(result result, format formatter) {
+	fmt.Printf("\nTIMELINE %s (%s windows)\n", result.benchmark, *result.window)
+	writer := newTableWriter()
+	fmt.Fprintln(writer, "TIME\tREQUESTS\tTHROUGHPUT\tMEAN LATENCY\tMAX LATENCY")
+	for _, window := range result.windows {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
+			time.Duration(window.Index)**result.window, format.count(int(window.Requests)),
+			format.throughput(float64(window.Requests)/result.window.Seconds()),
+			format.latency(window.Latency), format.latency(window.MaxLatency))
+	}
+	writer.Flush()
+}
+
+// mergeWindows merges the time windows of each worker by window index
+func mergeWindows(workerWindows [][]Window) []Window {
+	merged := make(map[uint32]*Window)
+	for _, windows := range workerWindows {
+		for _, window := range windows {
+			m, ok := merged[window.Index]
+			if !ok {
+				m = &Window{Index: window.Index}
+				merged[window.Index] = m
+			}
+			total := m.Latency*time.Duration(m.Requests) + window.Latency*time.Duration(window.Requests)
+			m.Requests += window.Requests
+			if m.Requests > 0 {
+				m.Latency = total / time.Duration(m.Requests)
+			}
+			if window.MaxLatency > m.MaxLatency {
+				m.MaxLatency = window.MaxLatency
+			}
+		}
+	}
+
+	windows := make([]Window, 0, len(merged))
+	for _, window := range merged {
+		windows = append(windows, *window)
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Index < windows[j].Index
+	})
+	return windows
 }
 
 type result struct {
 	benchmark          string
+	workers            int
 	requests           int
+	errors             int
 	duration           time.Duration
 	throughput         float64
+	targetThroughput   float64
 	meanLatency        time.Duration
-	latencyPercentiles map[float32]time.Duration
+	minLatency         time.Duration
+	maxLatency         time.Duration
+	stdDevLatency      time.Duration
+	latencyPercentiles latencyPercentiles
+	latencyRanges      map[float32]latencyRange
+	metrics            []scrapeSeries
+	window             *time.Duration
+	windows            []Window
+	verifyErr          error
+	incomplete         bool
+	workerRequests     []int
+	workersErr         error
+	workerResults      []workerResult
+}
+
+// workerResult is the result of a benchmark on a single worker
+type workerResult struct {
+	worker      int
+	requests    int
+	throughput  float64
+	meanLatency time.Duration
+}
+
+// pooledStdDev computes the standard deviation of the latencies of all workers' requests from the
+// number of requests, mean latency and standard deviation of each worker
+// The standard deviations of workers can't be averaged since the workers' means differ. Instead, the variance
+// of all requests is computed from the sums of the workers' squared latencies.
+type pooledStdDev struct {
+	count      float64
+	sum        float64
+	sumSquares float64
+}
+
+// add adds the latencies of a worker's requests
+func (p *pooledStdDev) add(requests int, mean, stdDev time.Duration) {
+	n := float64(requests)
+	p.count += n
+	p.sum += n * float64(mean)
+	p.sumSquares += n * (float64(stdDev)*float64(stdDev) + float64(mean)*float64(mean))
+}
+
+// mean returns the mean latency of all workers' requests
+// Each worker's mean latency is weighted by the number of requests it completed.
+func (p *pooledStdDev) mean() time.Duration {
+	if p.count == 0 {
+		return 0
+	}
+	return time.Duration(p.sum / p.count)
+}
+
+// stdDev returns the standard deviation of the latencies of all workers' requests
+func (p *pooledStdDev) stdDev() time.Duration {
+	if p.count == 0 {
+		return 0
+	}
+	mean := p.sum / p.count
+	return time.Duration(math.Sqrt(math.Max(p.sumSquares/p.count-mean*mean, 0)))
+}
+
+// latencyPercentile is the latency of a percentile of benchmark requests
+type latencyPercentile struct {
+	percentile float32
+	latency    time.Duration
+}
+
+// latencyPercentiles is a list of latency percentiles in ascending order of percentile
+// Percentiles are kept in a slice rather than a map so that results are always output in the same order.
+type latencyPercentiles []latencyPercentile
+
+// newLatencyPercentiles returns the given percentile latencies in ascending order of percentile
+func newLatencyPercentiles(latencies []PercentileLatency) latencyPercentiles {
+	percentiles := make(latencyPercentiles, 0, len(latencies))
+	for _, latency := range latencies {
+		percentiles = append(percentiles, latencyPercentile{percentile: latency.Percentile, latency: latency.Latency})
+	}
+	sort.Slice(percentiles, func(i, j int) bool {
+		return percentiles[i].percentile < percentiles[j].percentile
+	})
+	return percentiles
+}
+
+// newPercentileLatencies returns the given latency percentiles as percentile latencies
+func newPercentileLatencies(percentiles latencyPercentiles) []PercentileLatency {
+	latencies := make([]PercentileLatency, 0, len(percentiles))
+	for _, percentile := range percentiles {
+		latencies = append(latencies, PercentileLatency{Percentile: percentile.percentile, Latency: percentile.latency})
+	}
+	return latencies
+}
+
+// samePercentile returns whether the given percentiles are equal
+// Percentiles are compared with a tolerance since they may be parsed from percentages, e.g. p99.9.
+func samePercentile(p1, p2 float32) bool {
+	return math.Abs(float64(p1)-float64(p2)) < 1e-6
+}
+
+// get returns the latency of the given percentile, or 0 if the percentile is unknown
+func (p latencyPercentiles) get(percentile float32) time.Duration {
+	for _, latency := range p {
+		if samePercentile(latency.percentile, percentile) {
+			return latency.latency
+		}
+	}
+	return 0
+}
+
+// latencyRange is the range of a latency percentile across workers
+type latencyRange struct {
+	min time.Duration
+	max time.Duration
+}
+
+// update extends the range to include the given latency
+func (r latencyRange) update(latency time.Duration) latencyRange {
+	if r.min == 0 || latency < r.min {
+		r.min = latency
+	}
+	if latency > r.max {
+		r.max = latency
+	}
+	return r
+}
+
+// skewed returns whether the workers disagreed on the percentile by more than the skew factor
+func (r latencyRange) skewed() bool {
+	return r.min > 0 && float64(r.max) > float64(r.min)*latencySkewFactor
 }
@@ -0,0 +1,240 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCheckRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		requests    int
+		errors      int
+		minRequests int
+		fails       bool
+	}{
+		{name: "enough requests", requests: 10, minRequests: 10},
+		{name: "too few requests", requests: 9, minRequests: 10, fails: true},
+		{name: "errors are not successful requests", requests: 10, errors: 1, minRequests: 10, fails: true},
+		{name: "no requests", requests: 0, minRequests: 0, fails: true},
+		{name: "only errors", requests: 5, errors: 5, minRequests: 0, fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkRequests(result{benchmark: "Bench", requests: test.requests, errors: test.errors}, test.minRequests)
+			if test.fails {
+				assert.True(t, IsInsufficientRequests(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckErrorRate(t *testing.T) {
+	rate := func(rate float64) *float64 {
+		return &rate
+	}
+	tests := []struct {
+		name         string
+		requests     int
+		errors       int
+		maxErrorRate *float64
+		fails        bool
+	}{
+		{name: "no maximum", requests: 10, errors: 10},
+		{name: "no requests", requests: 0, maxErrorRate: rate(0)},
+		{name: "below maximum", requests: 100, errors: 1, maxErrorRate: rate(.05)},
+		{name: "at maximum", requests: 100, errors: 5, maxErrorRate: rate(.05)},
+		{name: "above maximum", requests: 100, errors: 6, maxErrorRate: rate(.05), fails: true},
+		{name: "no errors allowed", requests: 100, errors: 1, maxErrorRate: rate(0), fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkErrorRate(result{benchmark: "Bench", requests: test.requests, errors: test.errors}, test.maxErrorRate)
+			if test.fails {
+				assert.True(t, IsErrorRateExceeded(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckMaxLatency(t *testing.T) {
+	latency := func(latency time.Duration) *time.Duration {
+		return &latency
+	}
+	tests := []struct {
+		name       string
+		latency    time.Duration
+		maxLatency *time.Duration
+		fails      bool
+	}{
+		{name: "no maximum", latency: time.Hour},
+		{name: "below maximum", latency: 9 * time.Millisecond, maxLatency: latency(10 * time.Millisecond)},
+		{name: "at maximum", latency: 10 * time.Millisecond, maxLatency: latency(10 * time.Millisecond), fails: true},
+		{name: "above maximum", latency: 11 * time.Millisecond, maxLatency: latency(10 * time.Millisecond), fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkMaxLatency(result{benchmark: "Bench", meanLatency: test.latency}, test.maxLatency)
+			if test.fails {
+				assert.True(t, IsMaxLatencyExceeded(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckResults(t *testing.T) {
+	maxLatency := 10 * time.Millisecond
+	config := &Config{
+		MinRequests: 1,
+		MaxLatency:  &maxLatency,
+	}
+	assert.NoError(t, checkResults([]result{{benchmark: "Bench", requests: 10, meanLatency: time.Millisecond}}, config))
+
+	// Verification failures are reported before the other checks
+	verifyErr := &VerificationFailed{Benchmark: "Bench", Err: errors.New("failed")}
+	err := checkResults([]result{{benchmark: "Bench", requests: 0, verifyErr: verifyErr}}, config)
+	assert.True(t, IsVerificationFailed(err))
+
+	err = checkResults([]result{
+		{benchmark: "Fast", requests: 10, meanLatency: time.Millisecond},
+		{benchmark: "Slow", requests: 10, meanLatency: time.Second},
+	}, config)
+	assert.True(t, IsMaxLatencyExceeded(err))
+	assert.Equal(t, "Slow", err.(*MaxLatencyExceeded).Benchmark)
+}
+
+func TestPooledStdDev(t *testing.T) {
+	type workerLatency struct {
+		requests     int
+		mean, stdDev time.Duration
+	}
+	tests := []struct {
+		name    string
+		workers []workerLatency
+		mean    time.Duration
+		stdDev  time.Duration
+	}{
+		{
+			name: "no workers",
+		},
+		{
+			name: "single worker",
+			workers: []workerLatency{
+				{requests: 10, mean: 10 * time.Millisecond, stdDev: 2 * time.Millisecond},
+			},
+			mean:   10 * time.Millisecond,
+			stdDev: 2 * time.Millisecond,
+		},
+		{
+			name: "workers with constant latencies",
+			workers: []workerLatency{
+				{requests: 1, mean: 10 * time.Millisecond},
+				{requests: 1, mean: 30 * time.Millisecond},
+			},
+			mean:   20 * time.Millisecond,
+			stdDev: 10 * time.Millisecond,
+		},
+		{
+			// The pooled standard deviation is sqrt((3*10² + 50²)/4 - 20²) = sqrt(300) milliseconds
+			name: "mean weighted by requests",
+			workers: []workerLatency{
+				{requests: 3, mean: 10 * time.Millisecond},
+				{requests: 1, mean: 50 * time.Millisecond},
+			},
+			mean:   20 * time.Millisecond,
+			stdDev: time.Duration(math.Sqrt(300) * float64(time.Millisecond)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var pooled pooledStdDev
+			for _, worker := range test.workers {
+				pooled.add(worker.requests, worker.mean, worker.stdDev)
+			}
+			assert.InDelta(t, test.mean, pooled.mean(), float64(time.Microsecond))
+			assert.InDelta(t, test.stdDev, pooled.stdDev(), float64(time.Microsecond))
+		})
+	}
+}
+
+func TestMergeWindows(t *testing.T) {
+	tests := []struct {
+		name          string
+		workerWindows [][]Window
+		merged        []Window
+	}{
+		{
+			name:   "no workers",
+			merged: []Window{},
+		},
+		{
+			name: "single worker",
+			workerWindows: [][]Window{
+				{{Index: 0, Requests: 2, Latency: time.Millisecond, MaxLatency: 2 * time.Millisecond}},
+			},
+			merged: []Window{
+				{Index: 0, Requests: 2, Latency: time.Millisecond, MaxLatency: 2 * time.Millisecond},
+			},
+		},
+		{
+			name: "latency weighted by requests",
+			workerWindows: [][]Window{
+				{{Index: 0, Requests: 3, Latency: 10 * time.Millisecond, MaxLatency: 20 * time.Millisecond}},
+				{{Index: 0, Requests: 1, Latency: 50 * time.Millisecond, MaxLatency: 60 * time.Millisecond}},
+			},
+			merged: []Window{
+				{Index: 0, Requests: 4, Latency: 20 * time.Millisecond, MaxLatency: 60 * time.Millisecond},
+			},
+		},
+		{
+			name: "windows ordered by index",
+			workerWindows: [][]Window{
+				{{Index: 2, Requests: 1, Latency: time.Millisecond}, {Index: 0, Requests: 1, Latency: time.Millisecond}},
+				{{Index: 1, Requests: 1, Latency: time.Millisecond}},
+			},
+			merged: []Window{
+				{Index: 0, Requests: 1, Latency: time.Millisecond},
+				{Index: 1, Requests: 1, Latency: time.Millisecond},
+				{Index: 2, Requests: 1, Latency: time.Millisecond},
+			},
+		},
+		{
+			name: "empty windows",
+			workerWindows: [][]Window{
+				{{Index: 0}},
+				{{Index: 0}},
+			},
+			merged: []Window{
+				{Index: 0},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.merged, mergeWindows(test.workerWindows))
+		})
+	}
+}
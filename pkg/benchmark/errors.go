@@ -0,0 +1,212 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// MaxLatencyExceeded is returned when a benchmark's mean latency exceeds the configured maximum
+type MaxLatencyExceeded struct {
+	// Benchmark is the name of the benchmark that exceeded the maximum latency
+	Benchmark string
+	// Latency is the mean latency that exceeded the maximum
+	Latency time.Duration
+	// MaxLatency is the configured maximum latency
+	MaxLatency time.Duration
+	// Elapsed is the time elapsed in the benchmark when the maximum latency was exceeded, or zero
+	// if the latency was checked after the benchmark completed
+	Elapsed time.Duration
+}
+
+func (e *MaxLatencyExceeded) Error() string {
+	if e.Elapsed > 0 {
+		return fmt.Sprintf("benchmark %s stopped after %s: mean latency of %s exceeds maximum of %s", e.Benchmark, e.Elapsed.Round(time.Millisecond), e.Latency, e.MaxLatency)
+	}
+	return fmt.Sprintf("benchmark %s mean latency of %s exceeds maximum of %s", e.Benchmark, e.Latency, e.MaxLatency)
+}
+
+// VerificationFailed is returned when a benchmark's verification fails on a worker
+type VerificationFailed struct {
+	// Benchmark is the name of the benchmark that failed verification
+	Benchmark string
+	// Worker is the index of the worker on which verification failed
+	Worker int
+	// Err is the error returned by the verification
+	Err error
+}
+
+func (e *VerificationFailed) Error() string {
+	return fmt.Sprintf("benchmark %s verification failed on worker %d: %v", e.Benchmark, e.Worker, e.Err)
+}
+
+// TimedOut is returned when the benchmark job times out before a benchmark completes
+type TimedOut struct {
+	// Benchmark is the name of the benchmark that was stopped when the job timed out
+	Benchmark string
+	// Timeout is the job timeout
+	Timeout time.Duration
+}
+
+func (e *TimedOut) Error() string {
+	return fmt.Sprintf("benchmark %s was stopped before the job timeout of %s: results are incomplete", e.Benchmark, e.Timeout)
+}
+
+// InsufficientRequests is returned when a benchmark completes fewer successful requests than the configured minimum
+type InsufficientRequests struct {
+	// Benchmark is the name of the benchmark
+	Benchmark string
+	// Requests is the number of requests that completed successfully
+	Requests int
+	// Errors is the number of requests that returned an error
+	Errors int
+	// MinRequests is the configured minimum number of successful requests
+	MinRequests int
+}
+
+func (e *InsufficientRequests) Error() string {
+	if e.Requests <= 0 {
+		return fmt.Sprintf("benchmark %s completed no successful requests (%d errors)", e.Benchmark, e.Errors)
+	}
+	return fmt.Sprintf("benchmark %s completed %d successful requests (%d errors): minimum is %d", e.Benchmark, e.Requests, e.Errors, e.MinRequests)
+}
+
+// ErrorRateExceeded is returned when the ratio of a benchmark's requests that returned an error exceeds the
+// configured maximum
+type ErrorRateExceeded struct {
+	// Benchmark is the name of the benchmark
+	Benchmark string
+	// Requests is the number of requests issued
+	Requests int
+	// Errors is the number of requests that returned an error
+	Errors int
+	// MaxErrorRate is the configured maximum ratio of requests that return an error
+	MaxErrorRate float64
+}
+
+func (e *ErrorRateExceeded) Error() string {
+	return fmt.Sprintf("benchmark %s error rate of %.2f%% (%d of %d requests) exceeds maximum of %.2f%%",
+		e.Benchmark, float64(e.Errors)/float64(e.Requests)*100, e.Errors, e.Requests, e.MaxErrorRate*100)
+}
+
+// Aborted is returned when a benchmark is aborted by the benchmark code on a worker
+type Aborted struct {
+	// Benchmark is the name of the aborted benchmark
+	Benchmark string
+	// Worker is the index of the worker on which the benchmark was aborted
+	Worker int
+	// Reason is the reason given for aborting the benchmark
+	Reason string
+}
+
+func (e *Aborted) Error() string {
+	return fmt.Sprintf("benchmark %s aborted on worker %d: %s", e.Benchmark, e.Worker, e.Reason)
+}
+
+// WorkerFailed is returned when a request to a benchmark worker fails
+type WorkerFailed struct {
+	// Operation is the name of the failed request, e.g. SetupBenchmark
+	Operation string
+	// Worker is the index of the worker on which the request failed
+	Worker int
+	// Err is the error returned by the worker
+	Err error
+	// Logs is the tail of the worker's output, or an empty string if the output could not be retrieved
+	Logs string
+}
+
+func (e *WorkerFailed) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s failed on worker %d: %s", e.Operation, e.Worker, status.Convert(e.Err).Message())
+	if e.Logs != "" {
+		fmt.Fprintf(&b, "\nworker %d output:\n%s", e.Worker, strings.TrimRight(e.Logs, "\n"))
+	}
+	return b.String()
+}
+
+// WorkersFailed is returned when requests fail on any workers and errors are collected from all workers
+type WorkersFailed struct {
+	// Benchmark is the name of the benchmark that failed, or an empty string if the suite setup failed
+	Benchmark string
+	// Workers is the total number of workers
+	Workers int
+	// Errors are the errors returned by the failed workers in order of worker index
+	Errors []*WorkerFailed
+}
+
+func (e *WorkersFailed) Error() string {
+	var b strings.Builder
+	if e.Benchmark != "" {
+		fmt.Fprintf(&b, "benchmark %s failed on %d of %d workers", e.Benchmark, len(e.Errors), e.Workers)
+	} else {
+		fmt.Fprintf(&b, "%d of %d workers failed", len(e.Errors), e.Workers)
+	}
+	for _, err := range e.Errors {
+		fmt.Fprintf(&b, "\n%s", err.Error())
+	}
+	return b.String()
+}
+
+// IsWorkersFailed returns whether the given error is a WorkersFailed error
+func IsWorkersFailed(err error) bool {
+	_, ok := err.(*WorkersFailed)
+	return ok
+}
+
+// IsWorkerFailed returns whether the given error is a WorkerFailed error
+func IsWorkerFailed(err error) bool {
+	_, ok := err.(*WorkerFailed)
+	return ok
+}
+
+// IsAborted returns whether the given error is an Aborted error
+func IsAborted(err error) bool {
+	_, ok := err.(*Aborted)
+	return ok
+}
+
+// IsErrorRateExceeded returns whether the given error is an ErrorRateExceeded error
+func IsErrorRateExceeded(err error) bool {
+	_, ok := err.(*ErrorRateExceeded)
+	return ok
+}
+
+// IsInsufficientRequests returns whether the given error is an InsufficientRequests error
+func IsInsufficientRequests(err error) bool {
+	_, ok := err.(*InsufficientRequests)
+	return ok
+}
+
+// IsTimedOut returns whether the given error is a TimedOut error
+func IsTimedOut(err error) bool {
+	_, ok := err.(*TimedOut)
+	return ok
+}
+
+// IsVerificationFailed returns whether the given error is a VerificationFailed error
+func IsVerificationFailed(err error) bool {
+	_, ok := err.(*VerificationFailed)
+	return ok
+}
+
+// IsMaxLatencyExceeded returns whether the given error is a MaxLatencyExceeded error
+func IsMaxLatencyExceeded(err error) bool {
+	_, ok := err.(*MaxLatencyExceeded)
+	return ok
+}
@@ -0,0 +1,226 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// exporterProgressInterval is the interval at which the exporter updates the metrics of running benchmarks
+const exporterProgressInterval = 5 * time.Second
+
+// openMetricsContentType is the content type of the OpenMetrics text exposition format
+const openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// exporterKey identifies the metrics of a benchmark
+type exporterKey struct {
+	suite     string
+	benchmark string
+}
+
+// exporterMetrics is the metrics of a single benchmark
+type exporterMetrics struct {
+	completed          bool
+	requests           int
+	duration           time.Duration
+	throughput         float64
+	meanLatency        time.Duration
+	latencyPercentiles latencyPercentiles
+}
+
+// newMetricsExporter returns a new exporter serving benchmark metrics on the given port
+func newMetricsExporter(port int) *metricsExporter {
+	return &metricsExporter{
+		port:    port,
+		metrics: make(map[exporterKey]*exporterMetrics),
+	}
+}
+
+// metricsExporter serves the intermediate and final metrics of benchmarks in the OpenMetrics text format
+// so they can be scraped by Prometheus
+type metricsExporter struct {
+	port    int
+	server  *http.Server
+	metrics map[exporterKey]*exporterMetrics
+	mu      sync.RWMutex
+}
+
+// start starts serving metrics at /metrics
+func (e *metricsExporter) start() error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", e.port))
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e)
+	e.server = &http.Server{Handler: mux}
+	go func() {
+		_ = e.server.Serve(lis)
+	}()
+	return nil
+}
+
+// stop stops serving metrics once the given duration has elapsed to allow the final metrics to be scraped
+func (e *metricsExporter) stop(linger time.Duration) {
+	if linger > 0 {
+		fmt.Printf("Serving benchmark metrics on port %d for %s\n", e.port, linger)
+		time.Sleep(linger)
+	}
+	_ = e.server.Shutdown(context.Background())
+}
+
+// setProgress records the progress of a running benchmark
+func (e *metricsExporter) setProgress(suite, benchmark string, requests int, duration, latency time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var throughput float64
+	if duration > 0 {
+		throughput = float64(requests) / duration.Seconds()
+	}
+	e.metrics[exporterKey{suite, benchmark}] = &exporterMetrics{
+		requests:    requests,
+		duration:    duration,
+		throughput:  throughput,
+		meanLatency: latency,
+	}
+}
+
+// setResult records the final result of a benchmark
+func (e *metricsExporter) setResult(suite string, result result) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.metrics[exporterKey{suite, result.benchmark}] = &exporterMetrics{
+		completed:          !result.incomplete,
+		requests:           result.requests,
+		duration:           result.duration,
+		throughput:         result.throughput,
+		meanLatency:        result.meanLatency,
+		latencyPercentiles: result.latencyPercentiles,
+	}
+}
+
+// ServeHTTP writes the benchmark metrics in the OpenMetrics text format
+func (e *metricsExporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", openMetricsContentType)
+	e.write(w)
+}
+
+// write writes the benchmark metrics in the OpenMetrics text format
+func (e *metricsExporter) write(w io.Writer) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	keys := make([]exporterKey, 0, len(e.metrics))
+	for key := range e.metrics {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].suite != keys[j].suite {
+			return keys[i].suite < keys[j].suite
+		}
+		return keys[i].benchmark < keys[j].benchmark
+	})
+
+	labels := func(key exporterKey) string {
+		return fmt.Sprintf("suite=%q,benchmark=%q", key.suite, key.benchmark)
+	}
+
+	fmt.Fprintln(w, "# TYPE helmit_benchmark_completed gauge")
+	fmt.Fprintln(w, "# HELP helmit_benchmark_completed Whether the benchmark has completed.")
+	for _, key := range keys {
+		completed := 0
+		if e.metrics[key].completed {
+			completed = 1
+		}
+		fmt.Fprintf(w, "helmit_benchmark_completed{%s} %d\n", labels(key), completed)
+	}
+
+	fmt.Fprintln(w, "# TYPE helmit_benchmark_duration_seconds gauge")
+	fmt.Fprintln(w, "# HELP helmit_benchmark_duration_seconds The time for which the benchmark has run.")
+	for _, key := range keys {
+		fmt.Fprintf(w, "helmit_benchmark_duration_seconds{%s} %s\n", labels(key), formatFloat(e.metrics[key].duration.Seconds()))
+	}
+
+	fmt.Fprintln(w, "# TYPE helmit_benchmark_throughput gauge")
+	fmt.Fprintln(w, "# HELP helmit_benchmark_throughput The mean number of requests per second.")
+	for _, key := range keys {
+		fmt.Fprintf(w, "helmit_benchmark_throughput{%s} %s\n", labels(key), formatFloat(e.metrics[key].throughput))
+	}
+
+	fmt.Fprintln(w, "# TYPE helmit_benchmark_latency_seconds summary")
+	fmt.Fprintln(w, "# UNIT helmit_benchmark_latency_seconds seconds")
+	fmt.Fprintln(w, "# HELP helmit_benchmark_latency_seconds The latency of benchmark requests.")
+	for _, key := range keys {
+		metrics := e.metrics[key]
+		for _, percentile := range metrics.latencyPercentiles {
+			quantile := strconv.FormatFloat(float64(percentile.percentile), 'g', -1, 32)
+			fmt.Fprintf(w, "helmit_benchmark_latency_seconds{%s,quantile=\"%s\"} %s\n", labels(key), quantile, formatFloat(percentile.latency.Seconds()))
+		}
+		sum := metrics.meanLatency.Seconds() * float64(metrics.requests)
+		fmt.Fprintf(w, "helmit_benchmark_latency_seconds_sum{%s} %s\n", labels(key), formatFloat(sum))
+		fmt.Fprintf(w, "helmit_benchmark_latency_seconds_count{%s} %d\n", labels(key), metrics.requests)
+	}
+	fmt.Fprintln(w, "# EOF")
+}
+
+// formatFloat formats a float for the OpenMetrics text format
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// exportProgress periodically records the total progress of the given running benchmark until the context is done
+func (t *WorkerTask) exportProgress(ctx context.Context, benchmark string, workers []WorkerServiceClient) {
+	ticker := time.NewTicker(exporterProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		// Requests are not recorded while the benchmark is warming up
+		var requests uint32
+		var latencySum time.Duration
+		var elapsed time.Duration
+		for _, worker := range workers {
+			progress, err := worker.GetProgress(ctx, &ProgressRequest{
+				Suite:     t.config.Suite,
+				Benchmark: benchmark,
+				Total:     true,
+			})
+			if err != nil {
+				continue
+			}
+			requests += progress.Requests
+			latencySum += progress.Latency * time.Duration(progress.Requests)
+			if progress.Duration > elapsed {
+				elapsed = progress.Duration
+			}
+		}
+		if requests == 0 {
+			continue
+		}
+		t.exporter.setProgress(t.config.Suite, benchmark, int(requests), elapsed, latencySum/time.Duration(requests))
+	}
+}
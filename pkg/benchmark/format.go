@@ -0,0 +1,91 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// tablePadding is the number of spaces between table columns
+const tablePadding = 3
+
+// newTableWriter returns a writer for printing tables to stdout
+// Columns are sized to the widest cell in each column. Cells are written as plain text, so widths are
+// computed from the full cell contents.
+func newTableWriter() *tabwriter.Writer {
+	return tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+}
+
+// formatter formats benchmark results for display
+type formatter struct {
+	raw bool
+}
+
+// throughput formats a throughput in requests per second
+func (f formatter) throughput(throughput float64) string {
+	if f.raw {
+		return fmt.Sprintf("%f/sec", throughput)
+	}
+	return fmt.Sprintf("%s/sec", groupThousands(strconv.FormatFloat(throughput, 'f', 2, 64)))
+}
+
+// latency formats a latency scaled to the most readable unit
+func (f formatter) latency(latency time.Duration) string {
+	if f.raw {
+		return latency.String()
+	}
+	switch {
+	case latency < time.Millisecond:
+		return fmt.Sprintf("%.2fµs", float64(latency)/float64(time.Microsecond))
+	case latency < time.Second:
+		return fmt.Sprintf("%.2fms", float64(latency)/float64(time.Millisecond))
+	default:
+		return fmt.Sprintf("%.2fs", float64(latency)/float64(time.Second))
+	}
+}
+
+// count formats a count of requests
+func (f formatter) count(count int) string {
+	if f.raw {
+		return strconv.Itoa(count)
+	}
+	return groupThousands(strconv.Itoa(count))
+}
+
+// groupThousands inserts thousands separators into the integer part of the given decimal number
+func groupThousands(number string) string {
+	integer, fraction := number, ""
+	if index := strings.Index(number, "."); index != -1 {
+		integer, fraction = number[:index], number[index:]
+	}
+	sign := ""
+	if strings.HasPrefix(integer, "-") {
+		sign, integer = "-", integer[1:]
+	}
+
+	var b strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	return sign + b.String() + fraction
+}
@@ -0,0 +1,156 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"math"
+	"math/bits"
+	"time"
+)
+
+const (
+	// defaultHistogramPrecision is the default number of significant decimal digits recorded for latencies
+	defaultHistogramPrecision = 3
+	// defaultHistogramMax is the default maximum latency tracked by the histogram
+	defaultHistogramMax = time.Minute
+
+	minHistogramPrecision = 1
+	maxHistogramPrecision = 5
+)
+
+// histogram is a log-linear latency histogram
+// Latencies below the sub-bucket count are recorded exactly. Larger latencies are recorded in power of two buckets,
+// each divided into linear sub-buckets so every latency is recorded with a relative error of at most 10^-precision.
+// Latencies greater than the maximum are recorded as the maximum.
+type histogram struct {
+	subBucketBits int
+	max           int64
+	counts        []uint64
+	total         uint64
+}
+
+// newHistogram returns a new histogram with the given precision in significant decimal digits and maximum latency
+func newHistogram(precision int, max time.Duration) *histogram {
+	if precision < minHistogramPrecision || precision > maxHistogramPrecision {
+		precision = defaultHistogramPrecision
+	}
+	if max <= 0 {
+		max = defaultHistogramMax
+	}
+
+	// The sub-bucket count is the smallest power of two with half the count greater than 10^precision
+	subBucketBits := int(math.Ceil(math.Log2(2 * math.Pow10(precision))))
+	subBuckets := 1 << subBucketBits
+
+	// Each power of two bucket above the linear range adds half the sub-bucket count of counters
+	buckets := bits.Len64(uint64(max)) - subBucketBits
+	if buckets < 0 {
+		buckets = 0
+	}
+	return &histogram{
+		subBucketBits: subBucketBits,
+		max:           int64(max),
+		counts:        make([]uint64, subBuckets+buckets*(subBuckets/2)),
+	}
+}
+
+// record records the given latency
+func (h *histogram) record(latency time.Duration) {
+	value := int64(latency)
+	if value < 0 {
+		value = 0
+	} else if value > h.max {
+		value = h.max
+	}
+	h.counts[h.index(value)]++
+	h.total++
+}
+
+// buckets returns the non-empty buckets of the histogram
+func (h *histogram) buckets() []HistogramBucket {
+	var buckets []HistogramBucket
+	for index, n := range h.counts {
+		if n > 0 {
+			buckets = append(buckets, HistogramBucket{
+				Latency: time.Duration(h.value(index)),
+				Count:   n,
+			})
+		}
+	}
+	return buckets
+}
+
+// merge adds the counts of the given buckets, e.g. of another worker's histogram, to the histogram
+func (h *histogram) merge(buckets []HistogramBucket) {
+	for _, bucket := range buckets {
+		value := int64(bucket.Latency)
+		if value < 0 {
+			value = 0
+		} else if value > h.max {
+			value = h.max
+		}
+		h.counts[h.index(value)] += bucket.Count
+		h.total += bucket.Count
+	}
+}
+
+// index returns the index of the counter for the given value
+func (h *histogram) index(value int64) int {
+	subBuckets := int64(1) << h.subBucketBits
+	if value < subBuckets {
+		return int(value)
+	}
+	bucket := bits.Len64(uint64(value)) - h.subBucketBits
+	subBucket := value >> uint(bucket)
+	index := int(subBuckets) + (bucket-1)*int(subBuckets/2) + int(subBucket-subBuckets/2)
+	if index >= len(h.counts) {
+		return len(h.counts) - 1
+	}
+	return index
+}
+
+// value returns the highest value recorded by the counter at the given index
+func (h *histogram) value(index int) int64 {
+	subBuckets := 1 << h.subBucketBits
+	if index < subBuckets {
+		return int64(index)
+	}
+	bucket := (index-subBuckets)/(subBuckets/2) + 1
+	subBucket := int64((index-subBuckets)%(subBuckets/2) + subBuckets/2)
+	return (subBucket+1)<<uint(bucket) - 1
+}
+
+// percentile returns the latency at the given percentile in the range [0, 1]
+func (h *histogram) percentile(p float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	target := uint64(math.Ceil(p * float64(h.total)))
+	if target == 0 {
+		target = 1
+	}
+	var count uint64
+	for index, n := range h.counts {
+		count += n
+		if count >= target {
+			value := h.value(index)
+			if value > h.max {
+				value = h.max
+			}
+			return time.Duration(value)
+		}
+	}
+	return time.Duration(h.max)
+}
@@ -0,0 +1,82 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestHistogramIndex(t *testing.T) {
+	h := newHistogram(3, time.Minute)
+	subBuckets := int64(1) << h.subBucketBits
+
+	// Values below the sub-bucket count are recorded exactly
+	for _, value := range []int64{0, 1, 1000, subBuckets - 1} {
+		assert.Equal(t, int(value), h.index(value))
+		assert.Equal(t, value, h.value(h.index(value)))
+	}
+
+	// Larger values are recorded within the precision of the histogram
+	for _, value := range []int64{subBuckets, 12345678, int64(time.Second), int64(time.Minute)} {
+		index := h.index(value)
+		assert.True(t, index < len(h.counts))
+		assert.True(t, h.value(index) >= value)
+		assert.InDelta(t, value, h.value(index), float64(value)/1000)
+		if index > 0 {
+			assert.True(t, h.value(index-1) < value)
+		}
+	}
+
+	// Values beyond the maximum are recorded in the last counter
+	assert.Equal(t, len(h.counts)-1, h.index(int64(time.Hour)))
+}
+
+func TestHistogramPercentile(t *testing.T) {
+	h := newHistogram(3, time.Minute)
+	assert.Equal(t, time.Duration(0), h.percentile(.5))
+
+	for i := 1; i <= 1000; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+	for _, p := range []float64{.5, .75, .95, .99} {
+		expected := time.Duration(p*1000) * time.Millisecond
+		assert.InDelta(t, expected, h.percentile(p), float64(expected)/1000)
+	}
+	assert.InDelta(t, time.Millisecond, h.percentile(0), float64(time.Millisecond)/1000)
+	assert.InDelta(t, time.Second, h.percentile(1), float64(time.Second)/1000)
+
+	h.record(time.Hour)
+	assert.Equal(t, time.Minute, h.percentile(1))
+}
+
+func TestHistogramMerge(t *testing.T) {
+	fast := newHistogram(3, time.Minute)
+	slow := newHistogram(3, time.Minute)
+	for i := 0; i < 900; i++ {
+		fast.record(time.Millisecond)
+	}
+	for i := 0; i < 100; i++ {
+		slow.record(time.Second)
+	}
+
+	merged := newHistogram(3, time.Minute)
+	merged.merge(fast.buckets())
+	merged.merge(slow.buckets())
+	assert.Equal(t, uint64(1000), merged.total)
+	assert.InDelta(t, time.Millisecond, merged.percentile(.9), float64(time.Millisecond)/1000)
+	assert.InDelta(t, time.Second, merged.percentile(.91), float64(time.Second)/1000)
+}
@@ -0,0 +1,189 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/onosproject/helmit/pkg/helm"
+	"github.com/onosproject/helmit/pkg/registry"
+)
+
+// LocalConfigEnv is the environment variable used to run the benchmark binary in-process with the given JSON config
+const LocalConfigEnv = "HELMIT_BENCHMARK_CONFIG"
+
+// isLocal returns whether the binary was run to execute benchmarks in-process
+func isLocal() bool {
+	return os.Getenv(LocalConfigEnv) != ""
+}
+
+// runLocal runs the benchmarks in-process as a single worker
+// The worker's setup, run, and teardown methods are called directly rather than through a coordinator,
+// providing a fast loop for developing benchmark logic.
+func runLocal() error {
+	config := &Config{}
+	if err := json.Unmarshal([]byte(os.Getenv(LocalConfigEnv)), config); err != nil {
+		return err
+	}
+
+	err := helm.SetContext(&helm.Context{
+		WorkDir:      config.Context,
+		Values:       config.Values,
+		StringValues: config.StringValues,
+		ValueFiles:   config.ValueFiles,
+	})
+	if err != nil {
+		return err
+	}
+
+	worker, err := newWorker(config)
+	if err != nil {
+		return err
+	}
+
+	suites := []string{config.Suite}
+	if config.Suite == "" {
+		suites = registry.GetBenchmarkSuites()
+	}
+	if err := validateBenchmarks(suites, config.Benchmark); err != nil {
+		return err
+	}
+
+	// Truncate the report file to which the report of each suite is appended
+	if config.ReportFile != "" {
+		if err := ioutil.WriteFile(config.ReportFile, nil, 0644); err != nil {
+			return err
+		}
+	}
+
+	results := make([]result, 0)
+	for _, suite := range suites {
+		suiteResults, err := runLocalSuite(worker, config, suite)
+		if err != nil {
+			return err
+		}
+		if config.Format == FormatJSON {
+			if err := printReport(suite, suiteResults); err != nil {
+				return err
+			}
+		}
+		if config.ReportFile != "" {
+			if err := appendReport(config.ReportFile, suite, suiteResults); err != nil {
+				return err
+			}
+		}
+		results = append(results, suiteResults...)
+	}
+	if config.Format != FormatJSON {
+		printResults(results, getColumns(config, results), formatter{raw: config.Raw})
+	}
+	return checkResults(results, config)
+}
+
+// runLocalSuite runs the benchmarks in the given suite in-process
+func runLocalSuite(worker *Worker, config *Config, suite string) ([]result, error) {
+	ctx := context.Background()
+	suiteRequest := &SuiteRequest{
+		Suite: suite,
+		Args:  config.getWorkerArgs(0),
+	}
+	if !config.NoSetup {
+		if _, err := worker.SetupSuite(ctx, suiteRequest); err != nil {
+			return nil, err
+		}
+		defer worker.TearDownSuite(ctx, suiteRequest)
+	}
+
+	if _, err := worker.SetupWorker(ctx, suiteRequest); err != nil {
+		return nil, err
+	}
+	defer worker.TearDownWorker(ctx, suiteRequest)
+
+	benchmarks := []string{config.Benchmark}
+	if config.Benchmark == "" {
+		benchmarks = getBenchmarks(registry.GetBenchmarkSuite(suite))
+	}
+
+	results := make([]result, 0, len(benchmarks))
+	for _, benchmark := range benchmarks {
+		benchmarkRequest := &BenchmarkRequest{
+			Suite:     suite,
+			Benchmark: benchmark,
+			Args:      config.getWorkerArgs(0),
+		}
+		if _, err := worker.SetupBenchmark(ctx, benchmarkRequest); err != nil {
+			return nil, err
+		}
+		response, err := worker.RunBenchmark(ctx, &RunRequest{
+			Suite:              suite,
+			Benchmark:          benchmark,
+			Requests:           uint32(config.Iterations),
+			Duration:           config.Duration,
+			MaxLatency:         config.MaxLatency,
+			Parallelism:        uint32(config.Parallelism),
+			Args:               config.getWorkerArgs(0),
+			Window:             config.Window,
+			HistogramPrecision: uint32(config.HistogramPrecision),
+			HistogramMax:       config.HistogramMax,
+			Percentiles:        config.getPercentiles(),
+			Rate:               float64(config.TargetRate),
+			Warmup:             uint32(config.Warmup),
+		})
+		var verifyErr error
+		if err == nil {
+			if _, err := worker.VerifyBenchmark(ctx, benchmarkRequest); err != nil {
+				verifyErr = &VerificationFailed{
+					Benchmark: benchmark,
+					Err:       err,
+				}
+			}
+		}
+		_, _ = worker.TearDownBenchmark(ctx, benchmarkRequest)
+		if err != nil {
+			return nil, err
+		}
+		if response.AbortReason != "" {
+			return nil, &Aborted{
+				Benchmark: benchmark,
+				Reason:    response.AbortReason,
+			}
+		}
+		var throughput float64
+		if response.Duration > 0 {
+			throughput = float64(response.Requests) / response.Duration.Seconds()
+		}
+		results = append(results, result{
+			benchmark:          benchmark,
+			workers:            1,
+			requests:           int(response.Requests),
+			errors:             int(response.Errors),
+			duration:           response.Duration,
+			throughput:         throughput,
+			targetThroughput:   float64(config.TargetRate),
+			meanLatency:        response.Latency,
+			minLatency:         response.MinLatency,
+			maxLatency:         response.MaxLatency,
+			stdDevLatency:      response.StdDevLatency,
+			latencyPercentiles: newLatencyPercentiles(response.LatencyPercentiles),
+			window:             config.Window,
+			windows:            mergeWindows([][]Window{response.Windows}),
+			verifyErr:          verifyErr,
+		})
+	}
+	return results, nil
+}
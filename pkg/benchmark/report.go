@@ -0,0 +1,357 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/onosproject/helmit/pkg/job"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ReportSchemaVersion is the version of the benchmark report format
+// The version is incremented whenever a change is made to the format that's not backwards compatible.
+const ReportSchemaVersion = "v1"
+
+// Report is the machine-readable report of the results of a benchmark suite
+type Report struct {
+	// SchemaVersion is the version of the report format
+	SchemaVersion string `json:"schemaVersion"`
+	// Suite is the name of the benchmark suite
+	Suite string `json:"suite"`
+	// Revision is the git commit of the code under test, or "unknown" if it's not in a git repository
+	Revision string `json:"revision,omitempty"`
+	// Dirty indicates whether the working tree of the code under test had uncommitted changes
+	Dirty bool `json:"dirty,omitempty"`
+	// Results are the results of the benchmarks run in the suite
+	Results []Result `json:"results"`
+}
+
+// Result is the result of a single benchmark
+type Result struct {
+	// Benchmark is the name of the benchmark
+	Benchmark string `json:"benchmark"`
+	// Workers is the number of workers that ran the benchmark
+	Workers int `json:"workers"`
+	// Requests is the total number of requests completed by all workers
+	Requests int `json:"requests"`
+	// Errors is the number of requests that returned an error
+	Errors int `json:"errors,omitempty"`
+	// DurationNs is the duration of the benchmark in nanoseconds
+	DurationNs int64 `json:"durationNs"`
+	// Throughput is the number of requests completed per second
+	Throughput float64 `json:"throughput"`
+	// TargetThroughput is the configured target number of requests per second, or 0 if no target rate was configured
+	TargetThroughput float64 `json:"targetThroughput,omitempty"`
+	// MeanLatencyNs is the mean request latency in nanoseconds
+	MeanLatencyNs int64 `json:"meanLatencyNs"`
+	// MinLatencyNs is the minimum request latency in nanoseconds
+	MinLatencyNs int64 `json:"minLatencyNs,omitempty"`
+	// MaxLatencyNs is the maximum request latency in nanoseconds
+	MaxLatencyNs int64 `json:"maxLatencyNs,omitempty"`
+	// StdDevLatencyNs is the standard deviation of the request latency in nanoseconds
+	StdDevLatencyNs int64 `json:"stdDevLatencyNs,omitempty"`
+	// LatencyPercentiles are the request latency percentiles in ascending order
+	LatencyPercentiles []LatencyPercentile `json:"latencyPercentiles"`
+	// Windows are the results aggregated into time windows if a window was configured
+	Windows []ResultWindow `json:"windows,omitempty"`
+	// Metrics are the time series of the metrics scraped from the pods under test if scraping was configured
+	Metrics []ResultMetric `json:"metrics,omitempty"`
+	// Incomplete indicates the benchmark was stopped before it completed
+	Incomplete bool `json:"incomplete"`
+	// WorkerRequests are the number of requests completed by each worker if the benchmark is incomplete
+	WorkerRequests []int `json:"workerRequests,omitempty"`
+	// VerificationError is the error returned by the benchmark's verification if verification failed
+	VerificationError string `json:"verificationError,omitempty"`
+}
+
+// LatencyPercentile is a request latency percentile
+type LatencyPercentile struct {
+	// Percentile is the percentile in the range (0, 1]
+	Percentile float64 `json:"percentile"`
+	// LatencyNs is the latency at the percentile in nanoseconds
+	LatencyNs int64 `json:"latencyNs"`
+}
+
+// ResultWindow is the result of a benchmark within a time window
+type ResultWindow struct {
+	// Index is the index of the window from the start of the benchmark
+	Index int `json:"index"`
+	// Requests is the number of requests completed within the window
+	Requests int `json:"requests"`
+	// MeanLatencyNs is the mean latency of requests completed within the window in nanoseconds
+	MeanLatencyNs int64 `json:"meanLatencyNs"`
+	// MaxLatencyNs is the maximum latency of requests completed within the window in nanoseconds
+	MaxLatencyNs int64 `json:"maxLatencyNs"`
+}
+
+// ResultMetric is the time series of a metric scraped from a single pod while a benchmark was running
+type ResultMetric struct {
+	// Pod is the name of the pod from which the metric was scraped
+	Pod string `json:"pod"`
+	// Metric is the name and labels of the metric
+	Metric string `json:"metric"`
+	// Samples are the scraped samples in the order in which they were scraped
+	Samples []ResultSample `json:"samples"`
+}
+
+// ResultSample is a single sample of a scraped metric
+type ResultSample struct {
+	// TimeNs is the time at which the sample was scraped in nanoseconds since the Unix epoch
+	TimeNs int64 `json:"timeNs"`
+	// Value is the value of the metric
+	Value float64 `json:"value"`
+}
+
+// reportSchema is the JSON Schema for the current version of the report format
+const reportSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "https://github.com/onosproject/helmit/benchmark-report/v1",
+  "title": "Helmit benchmark report",
+  "type": "object",
+  "required": ["schemaVersion", "suite", "results"],
+  "additionalProperties": false,
+  "properties": {
+    "schemaVersion": {"const": "v1"},
+    "suite": {"type": "string"},
+    "revision": {"type": "string"},
+    "dirty": {"type": "boolean"},
+    "results": {
+      "type": "array",
+      "items": {"$ref": "#/definitions/result"}
+    }
+  },
+  "definitions": {
+    "result": {
+      "type": "object",
+      "required": ["benchmark", "workers", "requests", "durationNs", "throughput", "meanLatencyNs", "latencyPercentiles", "incomplete"],
+      "additionalProperties": false,
+      "properties": {
+        "benchmark": {"type": "string", "minLength": 1},
+        "workers": {"type": "integer", "minimum": 1},
+        "requests": {"type": "integer", "minimum": 0},
+        "errors": {"type": "integer", "minimum": 0},
+        "durationNs": {"type": "integer", "minimum": 0},
+        "throughput": {"type": "number", "minimum": 0},
+        "targetThroughput": {"type": "number", "minimum": 0},
+        "meanLatencyNs": {"type": "integer", "minimum": 0},
+        "minLatencyNs": {"type": "integer", "minimum": 0},
+        "maxLatencyNs": {"type": "integer", "minimum": 0},
+        "stdDevLatencyNs": {"type": "integer", "minimum": 0},
+        "latencyPercentiles": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": ["percentile", "latencyNs"],
+            "additionalProperties": false,
+            "properties": {
+              "percentile": {"type": "number", "exclusiveMinimum": 0, "maximum": 1},
+              "latencyNs": {"type": "integer", "minimum": 0}
+            }
+          }
+        },
+        "windows": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": ["index", "requests", "meanLatencyNs", "maxLatencyNs"],
+            "additionalProperties": false,
+            "properties": {
+              "index": {"type": "integer", "minimum": 0},
+              "requests": {"type": "integer", "minimum": 0},
+              "meanLatencyNs": {"type": "integer", "minimum": 0},
+              "maxLatencyNs": {"type": "integer", "minimum": 0}
+            }
+          }
+        },
+        "metrics": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": ["pod", "metric", "samples"],
+            "additionalProperties": false,
+            "properties": {
+              "pod": {"type": "string"},
+              "metric": {"type": "string"},
+              "samples": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "required": ["timeNs", "value"],
+                  "additionalProperties": false,
+                  "properties": {
+                    "timeNs": {"type": "integer"},
+                    "value": {"type": "number"}
+                  }
+                }
+              }
+            }
+          }
+        },
+        "incomplete": {"type": "boolean"},
+        "workerRequests": {
+          "type": "array",
+          "items": {"type": "integer", "minimum": 0}
+        },
+        "verificationError": {"type": "string"}
+      }
+    }
+  }
+}
+`
+
+// ReportSchema returns the JSON Schema describing the current version of the benchmark report format
+func ReportSchema() []byte {
+	return []byte(reportSchema)
+}
+
+// newReport returns a report of the given benchmark results
+func newReport(suite string, results []result) *Report {
+	revision := job.GetRevision()
+	report := &Report{
+		SchemaVersion: ReportSchemaVersion,
+		Suite:         suite,
+		Revision:      revision.Commit,
+		Dirty:         revision.Dirty,
+		Results:       make([]Result, 0, len(results)),
+	}
+	for _, result := range results {
+		report.Results = append(report.Results, newReportResult(result))
+	}
+	return report
+}
+
+// newReportResult returns the report of a single benchmark result
+func newReportResult(result result) Result {
+	r := Result{
+		Benchmark:          result.benchmark,
+		Workers:            result.workers,
+		Requests:           result.requests,
+		Errors:             result.errors,
+		DurationNs:         int64(result.duration),
+		Throughput:         result.throughput,
+		TargetThroughput:   result.targetThroughput,
+		MeanLatencyNs:      int64(result.meanLatency),
+		MinLatencyNs:       int64(result.minLatency),
+		MaxLatencyNs:       int64(result.maxLatency),
+		StdDevLatencyNs:    int64(result.stdDevLatency),
+		LatencyPercentiles: make([]LatencyPercentile, 0, len(result.latencyPercentiles)),
+		Incomplete:         result.incomplete,
+	}
+	for _, latency := range result.latencyPercentiles {
+		// Format the percentile at float32 precision to avoid reporting e.g. 0.99 as 0.9900000095367432
+		p, _ := strconv.ParseFloat(strconv.FormatFloat(float64(latency.percentile), 'g', -1, 32), 64)
+		r.LatencyPercentiles = append(r.LatencyPercentiles, LatencyPercentile{
+			Percentile: p,
+			LatencyNs:  int64(latency.latency),
+		})
+	}
+	for _, window := range result.windows {
+		r.Windows = append(r.Windows, ResultWindow{
+			Index:         int(window.Index),
+			Requests:      int(window.Requests),
+			MeanLatencyNs: int64(window.Latency),
+			MaxLatencyNs:  int64(window.MaxLatency),
+		})
+	}
+	for _, series := range result.metrics {
+		metric := ResultMetric{
+			Pod:     series.pod,
+			Metric:  series.metric,
+			Samples: make([]ResultSample, 0, len(series.samples)),
+		}
+		for _, sample := range series.samples {
+			metric.Samples = append(metric.Samples, ResultSample{
+				TimeNs: sample.time.UnixNano(),
+				Value:  sample.value,
+			})
+		}
+		r.Metrics = append(r.Metrics, metric)
+	}
+	if result.incomplete {
+		r.WorkerRequests = result.workerRequests
+	}
+	if result.verifyErr != nil {
+		r.VerificationError = result.verifyErr.Error()
+	}
+	return r
+}
+
+// printReport prints a JSON report of the given benchmark results on a single line
+func printReport(suite string, results []result) error {
+	bytes, err := marshalReport(newReport(suite, results))
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bytes))
+	return nil
+}
+
+// reportStream is the name of the output stream on which the coordinator writes reports for the report file
+const reportStream = "report"
+
+// writeReport writes a JSON report of the given benchmark results to the coordinator's output for the report file
+// The report is tagged with the report stream, so it's written to the report file rather than printed when the
+// coordinator's output is streamed.
+func writeReport(suite string, results []result) error {
+	bytes, err := marshalReport(newReport(suite, results))
+	if err != nil {
+		return err
+	}
+	fmt.Println(job.FormatOutput(reportStream, "", string(bytes)))
+	return nil
+}
+
+// appendReport appends a JSON report of the given benchmark results to the given file
+func appendReport(path string, suite string, results []result) error {
+	bytes, err := marshalReport(newReport(suite, results))
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(file, string(bytes)); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// marshalReport encodes the given report as JSON, validating it against the report schema
+func marshalReport(report *Report) ([]byte, error) {
+	bytes, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+
+	validation, err := gojsonschema.Validate(gojsonschema.NewStringLoader(reportSchema), gojsonschema.NewBytesLoader(bytes))
+	if err != nil {
+		return nil, err
+	}
+	if !validation.Valid() {
+		errs := make([]string, 0, len(validation.Errors()))
+		for _, err := range validation.Errors() {
+			errs = append(errs, err.String())
+		}
+		return nil, fmt.Errorf("benchmark report does not match schema %s: %s", ReportSchemaVersion, strings.Join(errs, "; "))
+	}
+	return bytes, nil
+}
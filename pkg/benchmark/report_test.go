@@ -0,0 +1,99 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMarshalReport(t *testing.T) {
+	valid := func() *Report {
+		return newReport("suite", []result{
+			{
+				benchmark:   "Bench",
+				workers:     2,
+				requests:    100,
+				errors:      1,
+				duration:    time.Second,
+				throughput:  100,
+				meanLatency: time.Millisecond,
+				latencyPercentiles: latencyPercentiles{
+					{percentile: .5, latency: time.Millisecond},
+					{percentile: .99, latency: 2 * time.Millisecond},
+				},
+			},
+		})
+	}
+
+	tests := []struct {
+		name   string
+		update func(report *Report)
+		valid  bool
+	}{
+		{
+			name:   "valid report",
+			update: func(report *Report) {},
+			valid:  true,
+		},
+		{
+			name: "unknown schema version",
+			update: func(report *Report) {
+				report.SchemaVersion = "v0"
+			},
+		},
+		{
+			name: "missing benchmark name",
+			update: func(report *Report) {
+				report.Results[0].Benchmark = ""
+			},
+		},
+		{
+			name: "no workers",
+			update: func(report *Report) {
+				report.Results[0].Workers = 0
+			},
+		},
+		{
+			name: "negative latency",
+			update: func(report *Report) {
+				report.Results[0].MeanLatencyNs = -1
+			},
+		},
+		{
+			name: "percentile out of range",
+			update: func(report *Report) {
+				report.Results[0].LatencyPercentiles[0].Percentile = 1.5
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := valid()
+			test.update(report)
+			bytes, err := marshalReport(report)
+			if test.valid {
+				assert.NoError(t, err)
+				decoded := &Report{}
+				assert.NoError(t, json.Unmarshal(bytes, decoded))
+				assert.Equal(t, report, decoded)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
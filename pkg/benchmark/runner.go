@@ -48,6 +48,13 @@ func Run(config *Config) error {
 		configContext = path.Base(config.Context)
 	}
 
+	// Reports are written by the coordinator to its output and from there to the report file
+	if config.ReportFile != "" {
+		config.Config.OutputFiles = map[string]string{
+			reportStream: config.ReportFile,
+		}
+	}
+
 	job := &jobs.Job{
 		Config: config.Config,
 		JobConfig: &Config{
@@ -62,22 +69,55 @@ func Run(config *Config) error {
 				Executable:      configExecutable,
 				Context:         configContext,
 				Values:          config.Values,
+				StringValues:    config.StringValues,
 				ValueFiles:      configValueFiles,
 				Args:            config.Config.Args,
 				Env:             config.Env,
 				Timeout:         config.Timeout,
 				NoTeardown:      config.NoTeardown,
 				Secrets:         config.Config.Secrets,
+				WaitContainers:  config.Config.WaitContainers,
 			},
-			Suite:       config.Suite,
-			Benchmark:   config.Benchmark,
-			Workers:     config.Workers,
-			Parallelism: config.Parallelism,
-			Iterations:  config.Iterations,
-			Duration:    config.Duration,
-			Args:        config.Args,
-			MaxLatency:  config.MaxLatency,
-			NoTeardown:  config.NoTeardown,
+			WorkerImage:        config.WorkerImage,
+			Suite:              config.Suite,
+			Benchmark:          config.Benchmark,
+			Workers:            config.Workers,
+			Parallelism:        config.Parallelism,
+			Iterations:         config.Iterations,
+			Duration:           config.Duration,
+			Args:               config.Args,
+			MaxLatency:         config.MaxLatency,
+			MaxLatencyWindow:   config.MaxLatencyWindow,
+			NoTeardown:         config.NoTeardown,
+			Scrape:             config.Scrape,
+			ScrapeMetrics:      config.ScrapeMetrics,
+			KeepaliveTime:      config.KeepaliveTime,
+			KeepaliveTimeout:   config.KeepaliveTimeout,
+			Raw:                config.Raw,
+			Format:             config.Format,
+			ReportFile:         config.ReportFile,
+			Columns:            config.Columns,
+			Window:             config.Window,
+			NoSetup:            config.NoSetup,
+			WorkerArgs:         config.WorkerArgs,
+			CheckpointInterval: config.CheckpointInterval,
+			Resume:             config.Resume,
+			HeadlessWorkers:    config.HeadlessWorkers,
+			HistogramPrecision: config.HistogramPrecision,
+			HistogramMax:       config.HistogramMax,
+			Percentiles:        config.Percentiles,
+			NoProgress:         config.NoProgress,
+			MetricsPort:        config.MetricsPort,
+			MetricsLinger:      config.MetricsLinger,
+			WorkerBatchSize:    config.WorkerBatchSize,
+			WorkerBatchDelay:   config.WorkerBatchDelay,
+			MinRequests:        config.MinRequests,
+			MaxErrorRate:       config.MaxErrorRate,
+			TargetRate:         config.TargetRate,
+			Warmup:             config.Warmup,
+			ContinueOnError:    config.ContinueOnError,
+			WorkerResults:      config.WorkerResults,
+			WorkerPort:         config.WorkerPort,
 		},
 		Type: benchmarkJobType,
 	}
@@ -95,6 +135,10 @@ func Main() {
 
 // run runs a benchmark
 func run() error {
+	if isLocal() {
+		return runLocal()
+	}
+
 	config := &Config{}
 	if err := jobs.Bootstrap(config); err != nil {
 		return err
@@ -0,0 +1,290 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onosproject/helmit/pkg/kubernetes"
+	"github.com/onosproject/helmit/pkg/util/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultScrapePath = "/metrics"
+const defaultScrapeInterval = 5 * time.Second
+
+// ScrapeTarget is a set of pods from which to scrape metrics while benchmarks are running
+type ScrapeTarget struct {
+	Selector string        `json:"selector,omitempty"`
+	Port     int           `json:"port,omitempty"`
+	Path     string        `json:"path,omitempty"`
+	Interval time.Duration `json:"interval,omitempty"`
+}
+
+// String returns the scrape target in its flag format
+func (t ScrapeTarget) String() string {
+	return fmt.Sprintf("%s:%d%s@%s", t.Selector, t.Port, t.Path, t.Interval)
+}
+
+// ParseScrapeTarget parses a scrape target in the format {selector}:{port}/{path}@{interval}
+// The path defaults to /metrics and the interval defaults to 5s when omitted.
+func ParseScrapeTarget(value string) (ScrapeTarget, error) {
+	raw := value
+	target := ScrapeTarget{
+		Path:     defaultScrapePath,
+		Interval: defaultScrapeInterval,
+	}
+
+	if index := strings.LastIndex(value, "@"); index != -1 {
+		interval, err := time.ParseDuration(value[index+1:])
+		if err != nil {
+			return target, fmt.Errorf("invalid scrape interval in %s: %v", raw, err)
+		}
+		if interval <= 0 {
+			return target, fmt.Errorf("scrape interval in %s must be positive", raw)
+		}
+		target.Interval = interval
+		value = value[:index]
+	}
+
+	index := strings.LastIndex(value, ":")
+	if index == -1 {
+		return target, fmt.Errorf("scrape target %s must be in the format {selector}:{port}/{path}@{interval}", raw)
+	}
+	target.Selector, value = value[:index], value[index+1:]
+	if target.Selector == "" {
+		return target, fmt.Errorf("scrape target %s is missing a pod selector", raw)
+	}
+
+	if index := strings.Index(value, "/"); index != -1 {
+		target.Path = value[index:]
+		value = value[:index]
+	}
+
+	port, err := strconv.Atoi(value)
+	if err != nil {
+		return target, fmt.Errorf("invalid scrape port %s: %v", value, err)
+	}
+	target.Port = port
+	return target, nil
+}
+
+// scrapeSample is a single sample of a scraped metric
+type scrapeSample struct {
+	time  time.Time
+	value float64
+}
+
+// scrapeSeries is a time series of samples scraped from a single pod
+type scrapeSeries struct {
+	pod     string
+	metric  string
+	samples []scrapeSample
+}
+
+// newScraper returns a new scraper for the given targets
+// If any metric filters are given, only metrics whose names match one of the regular expressions are recorded.
+func newScraper(client kubernetes.Client, id string, targets []ScrapeTarget, metrics []string) (*scraper, error) {
+	filters := make([]*regexp.Regexp, 0, len(metrics))
+	for _, metric := range metrics {
+		filter, err := regexp.Compile(metric)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scrape metric filter %s: %v", metric, err)
+		}
+		filters = append(filters, filter)
+	}
+	return &scraper{
+		client:  client,
+		id:      id,
+		targets: targets,
+		filters: filters,
+		series:  make(map[string]*scrapeSeries),
+	}, nil
+}
+
+// scraper periodically scrapes metrics from target pods
+type scraper struct {
+	client  kubernetes.Client
+	id      string
+	targets []ScrapeTarget
+	filters []*regexp.Regexp
+	series  map[string]*scrapeSeries
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// start starts scraping the targets in the background
+func (s *scraper) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	for _, target := range s.targets {
+		s.wg.Add(1)
+		go s.scrapeTarget(ctx, target)
+	}
+}
+
+// stop stops scraping and returns the collected time series ordered by pod and metric
+func (s *scraper) stop() []scrapeSeries {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	series := make([]scrapeSeries, 0, len(s.series))
+	for _, ts := range s.series {
+		series = append(series, *ts)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		if series[i].pod == series[j].pod {
+			return series[i].metric < series[j].metric
+		}
+		return series[i].pod < series[j].pod
+	})
+	return series
+}
+
+// scrapeTarget scrapes the given target at its interval until the context is cancelled
+func (s *scraper) scrapeTarget(ctx context.Context, target ScrapeTarget) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(target.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.scrape(ctx, target); err != nil && ctx.Err() == nil {
+			step := logging.NewStep(s.id, "Scrape %s", target)
+			step.Logf("Failed to scrape metrics: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// scrape scrapes metrics from all pods matching the target selector
+// A pod that can't be scraped, e.g. because it's restarting, doesn't prevent the remaining pods from being
+// scraped. The errors of all pods that failed are returned.
+func (s *scraper) scrape(ctx context.Context, target ScrapeTarget) error {
+	pods, err := s.client.Clientset().CoreV1().Pods(s.client.Namespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: target.Selector,
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, pod := range pods.Items {
+		bytes, err := s.client.Clientset().CoreV1().
+			Pods(pod.Namespace).
+			ProxyGet("http", pod.Name, strconv.Itoa(target.Port), target.Path, nil).
+			DoRaw(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", pod.Name, err))
+			continue
+		}
+		s.record(pod.Name, time.Now(), bytes)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to scrape pods: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// record records the metrics from a scraped Prometheus text exposition
+func (s *scraper) record(pod string, t time.Time, exposition []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	scanner := bufio.NewScanner(bytes.NewReader(exposition))
+	for scanner.Scan() {
+		metric, value, ok := parseMetric(scanner.Text())
+		if !ok || !s.matches(metric) {
+			continue
+		}
+		key := pod + "/" + metric
+		series, ok := s.series[key]
+		if !ok {
+			series = &scrapeSeries{
+				pod:    pod,
+				metric: metric,
+			}
+			s.series[key] = series
+		}
+		series.samples = append(series.samples, scrapeSample{
+			time:  t,
+			value: value,
+		})
+	}
+}
+
+// matches returns whether the name of the given metric matches the scraper's metric filters
+func (s *scraper) matches(metric string) bool {
+	if len(s.filters) == 0 {
+		return true
+	}
+	if index := strings.Index(metric, "{"); index != -1 {
+		metric = metric[:index]
+	}
+	for _, filter := range s.filters {
+		if filter.MatchString(metric) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMetric parses a single sample line in the Prometheus text exposition format
+func parseMetric(line string) (string, float64, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", 0, false
+	}
+
+	// Split the metric name and labels from the value and optional timestamp
+	var metric, rest string
+	if index := strings.IndexAny(line, "{ "); index != -1 && line[index] == '{' {
+		end := strings.Index(line, "}")
+		if end == -1 {
+			return "", 0, false
+		}
+		metric, rest = line[:end+1], line[end+1:]
+	} else if index != -1 {
+		metric, rest = line[:index], line[index:]
+	} else {
+		return "", 0, false
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", 0, false
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", 0, false
+	}
+	return metric, value, true
+}
@@ -19,45 +19,69 @@ import (
 	"fmt"
 	"github.com/onosproject/helmit/pkg/helm"
 	"github.com/onosproject/helmit/pkg/input"
+	jobs "github.com/onosproject/helmit/pkg/job"
 	"github.com/onosproject/helmit/pkg/registry"
 	"github.com/onosproject/helmit/pkg/util/logging"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 	"net"
 	"reflect"
 	"regexp"
+	"sync"
+	"time"
 )
 
 // newWorker returns a new benchmark worker
 func newWorker(config *Config) (*Worker, error) {
 	return &Worker{
-		config: config,
-		suites: make(map[string]BenchmarkingSuite),
+		config:     config,
+		suites:     make(map[string]BenchmarkingSuite),
+		benchmarks: make(map[string]*Benchmark),
 	}, nil
 }
 
 // Worker runs a benchmark job
 type Worker struct {
-	config *Config
-	suites map[string]BenchmarkingSuite
+	config     *Config
+	suites     map[string]BenchmarkingSuite
+	benchmarks map[string]*Benchmark
+	server     *grpc.Server
+	mu         sync.RWMutex
 }
 
 // Run runs a benchmark
 func (w *Worker) Run() error {
 	err := helm.SetContext(&helm.Context{
-		WorkDir:    w.config.Context,
-		Values:     w.config.Values,
-		ValueFiles: w.config.ValueFiles,
+		WorkDir:      w.config.Context,
+		Values:       w.config.Values,
+		StringValues: w.config.StringValues,
+		ValueFiles:   w.config.ValueFiles,
 	})
 	if err != nil {
 		return err
 	}
 
-	lis, err := net.Listen("tcp", ":5000")
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", getBenchmarkWorkerPort()))
 	if err != nil {
 		return err
 	}
-	server := grpc.NewServer()
+	// Permit the coordinator to ping idle connections to keep them open through network idle timeouts
+	server := grpc.NewServer(
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             w.config.getKeepaliveTime() / 2,
+			PermitWithoutStream: true,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    w.config.getKeepaliveTime(),
+			Timeout: w.config.getKeepaliveTimeout(),
+		}))
 	RegisterWorkerServiceServer(server, w)
+	w.server = server
+
+	// Mark the worker ready once the benchmark service has been registered and the listener bound
+	if err := jobs.SetServerReady(); err != nil {
+		return err
+	}
 	return server.Serve(lis)
 }
 
@@ -84,7 +108,7 @@ func (w *Worker) SetupSuite(ctx context.Context, request *SuiteRequest) (*SuiteR
 	}
 
 	if setupSuite, ok := suite.(SetupSuite); ok {
-		if err := setupSuite.SetupSuite(input.NewContext(request.Suite, request.Args)); err != nil {
+		if err := setupSuite.SetupSuite(input.NewWorkerContext(request.Suite, request.Args, int(request.Worker), int(request.Workers))); err != nil {
 			step.Fail(err)
 			return nil, err
 		}
@@ -106,7 +130,7 @@ func (w *Worker) TearDownSuite(ctx context.Context, request *SuiteRequest) (*Sui
 	}
 
 	if tearDownSuite, ok := suite.(TearDownSuite); ok {
-		if err := tearDownSuite.TearDownSuite(input.NewContext(request.Suite, request.Args)); err != nil {
+		if err := tearDownSuite.TearDownSuite(input.NewWorkerContext(request.Suite, request.Args, int(request.Worker), int(request.Workers))); err != nil {
 			step.Fail(err)
 			return nil, err
 		}
@@ -128,7 +152,7 @@ func (w *Worker) SetupWorker(ctx context.Context, request *SuiteRequest) (*Suite
 	}
 
 	if setupWorker, ok := suite.(SetupWorker); ok {
-		if err := setupWorker.SetupWorker(input.NewContext(request.Suite, request.Args)); err != nil {
+		if err := setupWorker.SetupWorker(input.NewWorkerContext(request.Suite, request.Args, int(request.Worker), int(request.Workers))); err != nil {
 			step.Fail(err)
 			return nil, err
 		}
@@ -150,7 +174,7 @@ func (w *Worker) TearDownWorker(ctx context.Context, request *SuiteRequest) (*Su
 	}
 
 	if tearDownWorker, ok := suite.(TearDownWorker); ok {
-		if err := tearDownWorker.TearDownWorker(input.NewContext(request.Suite, request.Args)); err != nil {
+		if err := tearDownWorker.TearDownWorker(input.NewWorkerContext(request.Suite, request.Args, int(request.Worker), int(request.Workers))); err != nil {
 			step.Fail(err)
 			return nil, err
 		}
@@ -160,6 +184,36 @@ func (w *Worker) TearDownWorker(ctx context.Context, request *SuiteRequest) (*Su
 	return &SuiteResponse{}, nil
 }
 
+// Shutdown tears down the worker and suite and gracefully stops the worker's server
+// The server is stopped once in-flight requests, including this one, have completed, allowing the worker to exit cleanly.
+func (w *Worker) Shutdown(ctx context.Context, request *ShutdownRequest) (*ShutdownResponse, error) {
+	step := logging.NewStep(fmt.Sprintf("%s/%d", request.Suite, getBenchmarkWorker()), "Shutdown %s", request.Suite)
+	step.Start()
+
+	suiteRequest := &SuiteRequest{
+		Suite:   request.Suite,
+		Args:    request.Args,
+		Worker:  request.Worker,
+		Workers: request.Workers,
+	}
+	if _, err := w.TearDownWorker(ctx, suiteRequest); err != nil {
+		step.Fail(err)
+		return nil, err
+	}
+	if request.TearDownSuite {
+		if _, err := w.TearDownSuite(ctx, suiteRequest); err != nil {
+			step.Fail(err)
+			return nil, err
+		}
+	}
+
+	if w.server != nil {
+		go w.server.GracefulStop()
+	}
+	step.Complete()
+	return &ShutdownResponse{}, nil
+}
+
 // SetupBenchmark sets up a benchmark
 func (w *Worker) SetupBenchmark(ctx context.Context, request *BenchmarkRequest) (*BenchmarkResponse, error) {
 	step := logging.NewStep(fmt.Sprintf("%s/%d", request.Suite, getBenchmarkWorker()), "SetupBenchmark %s", request.Benchmark)
@@ -171,7 +225,7 @@ func (w *Worker) SetupBenchmark(ctx context.Context, request *BenchmarkRequest)
 		return nil, err
 	}
 
-	context := input.NewContext(request.Benchmark, request.Args)
+	context := input.NewWorkerContext(request.Benchmark, request.Args, int(request.Worker), int(request.Workers))
 	if setupBenchmark, ok := suite.(SetupBenchmark); ok {
 		if err := setupBenchmark.SetupBenchmark(context); err != nil {
 			step.Fail(err)
@@ -199,7 +253,7 @@ func (w *Worker) TearDownBenchmark(ctx context.Context, request *BenchmarkReques
 		return nil, err
 	}
 
-	context := input.NewContext(request.Benchmark, request.Args)
+	context := input.NewWorkerContext(request.Benchmark, request.Args, int(request.Worker), int(request.Workers))
 	if tearDownBenchmark, ok := suite.(TearDownBenchmark); ok {
 		if err := tearDownBenchmark.TearDownBenchmark(context); err != nil {
 			step.Fail(err)
@@ -216,6 +270,40 @@ func (w *Worker) TearDownBenchmark(ctx context.Context, request *BenchmarkReques
 	return &BenchmarkResponse{}, nil
 }
 
+// VerifyBenchmark verifies the results of a benchmark
+func (w *Worker) VerifyBenchmark(ctx context.Context, request *BenchmarkRequest) (*BenchmarkResponse, error) {
+	step := logging.NewStep(fmt.Sprintf("%s/%d", request.Suite, getBenchmarkWorker()), "VerifyBenchmark %s", request.Benchmark)
+	step.Start()
+
+	suite, err := w.getSuite(request.Suite)
+	if err != nil {
+		step.Fail(err)
+		return nil, err
+	}
+
+	context := input.NewWorkerContext(request.Benchmark, request.Args, int(request.Worker), int(request.Workers))
+	if verifyBenchmark, ok := suite.(VerifyBenchmark); ok {
+		if err := verifyBenchmark.VerifyBenchmark(context); err != nil {
+			step.Fail(err)
+			return nil, err
+		}
+	}
+
+	methods := reflect.TypeOf(suite)
+	if method, ok := methods.MethodByName("Verify" + request.Benchmark); ok {
+		values := method.Func.Call([]reflect.Value{reflect.ValueOf(suite), reflect.ValueOf(context)})
+		if len(values) > 0 && values[0].Kind() == reflect.Interface && !values[0].IsNil() {
+			if err, ok := values[0].Interface().(error); ok {
+				step.Fail(err)
+				return nil, err
+			}
+		}
+	}
+
+	step.Complete()
+	return &BenchmarkResponse{}, nil
+}
+
 // RunBenchmark runs a benchmark
 func (w *Worker) RunBenchmark(ctx context.Context, request *RunRequest) (*RunResponse, error) {
 	step := logging.NewStep(fmt.Sprintf("%s/%d", request.Suite, getBenchmarkWorker()), "RunBenchmark %s", request.Benchmark)
@@ -227,16 +315,80 @@ func (w *Worker) RunBenchmark(ctx context.Context, request *RunRequest) (*RunRes
 		return nil, err
 	}
 
-	context := input.NewContext(request.Benchmark, request.Args)
-	benchmark := newBenchmark(int(request.Requests), request.Duration, int(request.Parallelism), request.MaxLatency, context)
+	context := input.NewWorkerContext(request.Benchmark, request.Args, int(request.Worker), int(request.Workers))
+	var histogramMax time.Duration
+	if request.HistogramMax != nil {
+		histogramMax = *request.HistogramMax
+	}
+	benchmark := newBenchmark(int(request.Requests), request.Duration, int(request.Parallelism), request.MaxLatency, request.Window, int(request.HistogramPrecision), histogramMax, request.Percentiles, request.Rate, int(request.Warmup), context)
+	key := getBenchmarkKey(request.Suite, request.Benchmark)
+	w.mu.Lock()
+	w.benchmarks[key] = benchmark
+	w.mu.Unlock()
+	defer func() {
+		w.mu.Lock()
+		delete(w.benchmarks, key)
+		w.mu.Unlock()
+	}()
+
 	result, err := benchmark.run(suite)
 	if err != nil {
 		return nil, err
 	}
-	step.Complete()
+	if result.AbortReason != "" {
+		step.Fail(fmt.Errorf("aborted: %s", result.AbortReason))
+	} else {
+		step.Complete()
+	}
 	return result, nil
 }
 
+// GetProgress gets the progress of a running benchmark
+func (w *Worker) GetProgress(ctx context.Context, request *ProgressRequest) (*ProgressResponse, error) {
+	w.mu.RLock()
+	benchmark, ok := w.benchmarks[getBenchmarkKey(request.Suite, request.Benchmark)]
+	w.mu.RUnlock()
+	if !ok {
+		return &ProgressResponse{}, nil
+	}
+	var requests int
+	var latency time.Duration
+	if request.Total {
+		requests, latency = benchmark.total()
+	} else {
+		requests, latency = benchmark.progress()
+	}
+	var percentileLatency time.Duration
+	if request.Percentile > 0 {
+		percentileLatency = benchmark.percentile(request.Percentile)
+	}
+	return &ProgressResponse{
+		Requests:          uint32(requests),
+		Latency:           latency,
+		PercentileLatency: percentileLatency,
+		Duration:          benchmark.elapsed(),
+	}, nil
+}
+
+// StopBenchmark stops a running benchmark
+func (w *Worker) StopBenchmark(ctx context.Context, request *StopRequest) (*StopResponse, error) {
+	w.mu.RLock()
+	benchmark, ok := w.benchmarks[getBenchmarkKey(request.Suite, request.Benchmark)]
+	w.mu.RUnlock()
+	if ok {
+		step := logging.NewStep(fmt.Sprintf("%s/%d", request.Suite, getBenchmarkWorker()), "StopBenchmark %s", request.Benchmark)
+		step.Start()
+		benchmark.stop()
+		step.Complete()
+	}
+	return &StopResponse{}, nil
+}
+
+// getBenchmarkKey returns the key for a running benchmark
+func getBenchmarkKey(suite, benchmark string) string {
+	return fmt.Sprintf("%s/%s", suite, benchmark)
+}
+
 // benchmarkFilter filters benchmark method names
 func benchmarkFilter(name string) (bool, error) {
 	if ok, _ := regexp.MatchString("^Benchmark", name); !ok {
@@ -15,12 +15,21 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/onosproject/helmit/pkg/helm"
 	"github.com/onosproject/helmit/pkg/job"
+	kubernetesconfig "github.com/onosproject/helmit/pkg/kubernetes/config"
 
 	"github.com/onosproject/helmit/pkg/benchmark"
 	"github.com/onosproject/helmit/pkg/util/random"
@@ -36,6 +45,15 @@ const benchExamples = `
   # The specified context will be loaded into the benchmark pods as the current working directory.
   helmit bench ./cmd/benchmarks --context ./charts --iterations 1000
 
+  # Run benchmark workers in a separate image with additional load generation tooling.
+  helmit bench ./cmd/benchmarks --worker-image atomix/load-generator:latest --duration 5m
+
+  # Run benchmark workers against an already deployed system without setting up the suite.
+  helmit bench ./cmd/benchmarks --no-setup --args endpoint=atomix-raft.staging:5678 --duration 5m
+
+  # Run a single benchmark worker in-process to develop benchmark logic.
+  helmit bench ./cmd/benchmarks --local --suite atomix --iterations 100
+
   # Run benchmarks in a specific namespace.
   helmit bench ./cmd/benchmarks -n bench --suite atomix --duration 5m
 
@@ -51,6 +69,19 @@ const benchExamples = `
   # Parallelize benchmark clients across worker pods.
   helmit bench ./cmd/benchmarks -c ./charts --suite atomix --workers 4 --duration 1m
 
+  # Address a large number of workers through a single headless service.
+  helmit bench ./cmd/benchmarks -c ./charts --suite atomix --workers 50 --headless-workers --duration 1m
+
+  # Create workers in batches of 10, waiting 5 seconds between batches.
+  helmit bench ./cmd/benchmarks -c ./charts --suite atomix --workers 100 --worker-batch-size 10 --worker-batch-delay 5s --duration 1m
+
+  # Scrape Prometheus metrics from pods matching a label selector every 10 seconds while benchmarks are running.
+  helmit bench ./cmd/benchmarks -c ./charts --suite atomix --scrape app=atomix-raft:5678/metrics@10s --duration 5m
+
+  # Checkpoint the progress of benchmarks every 30 seconds, and resume a failed run from its last checkpoint.
+  helmit bench ./cmd/benchmarks -c ./charts --suite atomix --duration 1h --checkpoint-interval 30s
+  helmit bench ./cmd/benchmarks -c ./charts --suite atomix --duration 1h --checkpoint-interval 30s --resume happy-panda
+
   # Override Helm chart values with flags.
   # Value overrids must be namespaced with the name of the release to which to apply the value.
   helmit bench ./cmd/benchmarks -c ./charts --set atomix-controller.image=atomix/atomix-controller:latest --set atomix-raft.replicas=3 --suite atomix --iterations 1000
@@ -73,27 +104,67 @@ func getBenchCommand() *cobra.Command {
 	cmd.Flags().String("service-account", "", "the name of the service account to use to run worker pods")
 	cmd.Flags().StringToString("labels", map[string]string{}, "a mapping of labels to add to the test pod")
 	cmd.Flags().StringToString("annotations", map[string]string{}, "a mapping of annotations to add to the test pod")
+	cmd.Flags().StringArray("wait-container", []string{}, "the name of a container, e.g. an injected sidecar, that must be ready in the benchmark pods before benchmarks are started")
+	cmd.Flags().StringArray("pod-annotation", []string{}, "an annotation to add to the coordinator and worker pods in the format {key}={value}")
 	cmd.Flags().StringP("context", "c", "", "the benchmark context")
+	cmd.Flags().String("preset", "", "the name of a preset of flags to apply from the presets.yaml file in the benchmark context")
 	cmd.Flags().StringP("image", "i", "", "the benchmark image to run")
+	cmd.Flags().String("worker-image", "", "the image to run on benchmark workers (defaults to --image)")
 	cmd.Flags().String("image-pull-policy", string(corev1.PullIfNotPresent), "the Docker image pull policy")
 	cmd.Flags().StringArrayP("values", "f", []string{}, "release values paths")
 	cmd.Flags().StringArray("set", []string{}, "cluster argument overrides")
+	cmd.Flags().StringArray("set-string", []string{}, "chart value overrides whose values are always strings")
 	cmd.Flags().StringP("suite", "s", "", "the benchmark suite to run")
 	cmd.Flags().StringP("benchmark", "b", "", "the name of the benchmark to run")
 	cmd.Flags().IntP("workers", "w", 1, "the number of workers to run")
 	cmd.Flags().Int("parallel", 1, "the number of concurrent goroutines per client")
 	cmd.Flags().IntP("iterations", "", 0, "the number of iterations to run")
 	cmd.Flags().DurationP("max-latency", "m", 0, "maximum latency allowed")
+	cmd.Flags().Duration("max-latency-window", 0, "stop a running benchmark once the mean latency exceeds --max-latency for this duration")
 	cmd.Flags().DurationP("duration", "d", 0, "the duration for which to run the test")
 	cmd.Flags().StringToStringP("args", "a", map[string]string{}, "a mapping of named benchmark arguments")
+	cmd.Flags().StringArray("worker-args", []string{}, "a named benchmark argument for a single worker in the format {worker}:{key}={value}")
 	cmd.Flags().Duration("timeout", 10*time.Minute, "benchmark timeout")
 	cmd.Flags().Bool("no-teardown", false, "do not tear down clusters following benchmarks")
+	cmd.Flags().Bool("rebuild", false, "rebuild the benchmark package even if an unchanged build is cached")
 	cmd.Flags().StringSlice("secret", []string{}, "secrets to pass to the kubernetes pod")
+	cmd.Flags().Duration("keepalive-time", 30*time.Second, "the interval at which idle worker connections are pinged")
+	cmd.Flags().Duration("keepalive-timeout", 10*time.Second, "the time to wait for a worker connection keepalive ping to be acknowledged")
+	cmd.Flags().Bool("local", false, "run a single benchmark worker in-process against the current kubeconfig context")
+	cmd.Flags().Bool("raw", false, "print unrounded benchmark results")
+	cmd.Flags().StringSlice("columns", []string{}, "the columns of the results table to print, in order: benchmark, workers, requests, errors, error-rate, duration, throughput, target, mean, min, max, stddev, or p<percent> for a latency percentile, e.g. p99.9 (default benchmark,requests,duration,throughput,mean followed by a column per percentile, with errors,error-rate when requests fail)")
+	cmd.Flags().Float32Slice("percentiles", []float32{50, 75, 95, 99}, "the latency percentiles to compute, in percent")
+	cmd.Flags().String("format", benchmark.FormatText, "the format in which to print benchmark results: 'text' or 'json'")
+	cmd.Flags().String("report-file", "", "a local file to which to write a JSON report of each suite's results")
+	cmd.Flags().Bool("no-progress", false, "disable the live progress line displayed while benchmarks are running")
+	cmd.Flags().Bool("no-setup", false, "skip the suite setup and teardown to benchmark an externally managed system")
+	cmd.Flags().Bool("headless-workers", false, "address workers by their stable pod DNS names through a single headless service")
+	cmd.Flags().Duration("checkpoint-interval", 0, "the interval at which to checkpoint the progress of running benchmarks")
+	cmd.Flags().String("resume", "", "the ID of a failed benchmark run to resume from its last checkpoint")
+	cmd.Flags().Int("histogram-precision", 3, "the number of significant decimal digits with which latencies are recorded, from 1 to 5")
+	cmd.Flags().Duration("histogram-max", time.Minute, "the maximum latency tracked by the latency histogram; greater latencies are recorded as the maximum")
+	cmd.Flags().Int("metrics-port", 0, "serve benchmark metrics in the OpenMetrics format on this port of the coordinator pod")
+	cmd.Flags().Int("min-requests", 1, "the minimum number of successful requests for a benchmark to pass")
+	cmd.Flags().Int("worker-port", 5000, "the port on which benchmark workers serve requests from the coordinator")
+	cmd.Flags().Bool("worker-results", false, "print the requests, throughput and mean latency of each worker below the results table")
+	cmd.Flags().Bool("continue-on-error", false, "collect errors from all workers and report the results of the workers that succeeded rather than failing on the first worker error")
+	cmd.Flags().Int("warmup", 0, "the number of requests each worker issues before recording requests; by default workers warm up for 30 seconds")
+	cmd.Flags().Int("target-rate", 0, "the target number of requests per second issued across all workers; by default requests are issued as fast as possible")
+	cmd.Flags().Float64("max-error-rate", 0, "the maximum percentage of requests that may return an error for a benchmark to pass, from 0 to 100")
+	cmd.Flags().Int("worker-batch-size", 0, "the number of workers to create at a time, waiting for each batch to start before creating the next (0 creates all workers at once)")
+	cmd.Flags().Duration("worker-batch-delay", 0, "the time to wait between creating batches of workers")
+	cmd.Flags().Duration("metrics-linger", time.Minute, "the time for which to serve the final benchmark metrics once the benchmarks complete")
+	cmd.Flags().Duration("window", 0, "aggregate benchmark throughput and latency into time windows of this duration and print the timeline")
+	cmd.Flags().StringArray("scrape", []string{}, "scrape metrics from pods during benchmarks in the format {selector}:{port}/{path}@{interval}")
+	cmd.Flags().StringArray("scrape-metric", []string{}, "a regular expression matching the names of the scraped metrics to record, by default all metrics")
 	return cmd
 }
 
 func runBenchCommand(cmd *cobra.Command, args []string) error {
 	setupCommand(cmd)
+	if err := applyPreset(cmd); err != nil {
+		return err
+	}
 
 	pkgPath := ""
 	if len(args) > 0 {
@@ -103,9 +174,12 @@ func runBenchCommand(cmd *cobra.Command, args []string) error {
 	namespace, _ := cmd.Flags().GetString("namespace")
 	serviceAccount, _ := cmd.Flags().GetString("service-account")
 	labels, _ := cmd.Flags().GetStringToString("labels")
-	annotations, _ := cmd.Flags().GetStringToString("annotations")
+	annotationsMap, _ := cmd.Flags().GetStringToString("annotations")
+	podAnnotations, _ := cmd.Flags().GetStringArray("pod-annotation")
+	waitContainers, _ := cmd.Flags().GetStringArray("wait-container")
 	context, _ := cmd.Flags().GetString("context")
 	image, _ := cmd.Flags().GetString("image")
+	workerImage, _ := cmd.Flags().GetString("worker-image")
 	suite, _ := cmd.Flags().GetString("suite")
 	benchmarkName, _ := cmd.Flags().GetString("benchmark")
 	workers, _ := cmd.Flags().GetInt("workers")
@@ -114,31 +188,99 @@ func runBenchCommand(cmd *cobra.Command, args []string) error {
 	duration, _ := cmd.Flags().GetDuration("duration")
 	files, _ := cmd.Flags().GetStringArray("values")
 	sets, _ := cmd.Flags().GetStringArray("set")
+	setStrings, _ := cmd.Flags().GetStringArray("set-string")
 	benchArgs, _ := cmd.Flags().GetStringToString("args")
+	workerArgsArray, _ := cmd.Flags().GetStringArray("worker-args")
 	timeout, _ := cmd.Flags().GetDuration("timeout")
+	keepaliveTime, _ := cmd.Flags().GetDuration("keepalive-time")
+	keepaliveTimeout, _ := cmd.Flags().GetDuration("keepalive-timeout")
+	raw, _ := cmd.Flags().GetBool("raw")
+	format, _ := cmd.Flags().GetString("format")
+	reportFile, _ := cmd.Flags().GetString("report-file")
+	columns, _ := cmd.Flags().GetStringSlice("columns")
+	percents, _ := cmd.Flags().GetFloat32Slice("percentiles")
+	noSetup, _ := cmd.Flags().GetBool("no-setup")
+	noProgress, _ := cmd.Flags().GetBool("no-progress")
+	local, _ := cmd.Flags().GetBool("local")
+	checkpointInterval, _ := cmd.Flags().GetDuration("checkpoint-interval")
+	headlessWorkers, _ := cmd.Flags().GetBool("headless-workers")
+	histogramPrecision, _ := cmd.Flags().GetInt("histogram-precision")
+	histogramMax, _ := cmd.Flags().GetDuration("histogram-max")
+	metricsPort, _ := cmd.Flags().GetInt("metrics-port")
+	metricsLinger, _ := cmd.Flags().GetDuration("metrics-linger")
+	workerBatchSize, _ := cmd.Flags().GetInt("worker-batch-size")
+	minRequests, _ := cmd.Flags().GetInt("min-requests")
+	targetRate, _ := cmd.Flags().GetInt("target-rate")
+	warmup, _ := cmd.Flags().GetInt("warmup")
+	continueOnError, _ := cmd.Flags().GetBool("continue-on-error")
+	workerResults, _ := cmd.Flags().GetBool("worker-results")
+	workerPort, _ := cmd.Flags().GetInt("worker-port")
+	workerBatchDelay, _ := cmd.Flags().GetDuration("worker-batch-delay")
+	resume, _ := cmd.Flags().GetString("resume")
 	imagePullPolicy, _ := cmd.Flags().GetString("image-pull-policy")
 	pullPolicy := corev1.PullPolicy(imagePullPolicy)
 	noTeardown, _ := cmd.Flags().GetBool("no-teardown")
+	rebuild, _ := cmd.Flags().GetBool("rebuild")
 	secretsArray, _ := cmd.Flags().GetStringSlice("secret")
+	scrapes, _ := cmd.Flags().GetStringArray("scrape")
+	scrapeMetrics, _ := cmd.Flags().GetStringArray("scrape-metric")
 
 	// Either --iterations or --duration must be specified
 	if iterations == 0 && duration == 0 {
 		return errors.New("either --iterations or --duration must be specified")
 	}
 
+	if format != benchmark.FormatText && format != benchmark.FormatJSON {
+		return fmt.Errorf("unknown --format '%s': must be 'text' or 'json'", format)
+	}
+	if err := benchmark.ValidateColumns(columns); err != nil {
+		return err
+	}
+
 	// Either a command package or image must be specified
 	if pkgPath == "" && image == "" {
 		return errors.New("must specify either a benchmark package or --image to run")
 	}
+	if err := validateImage(image, pullPolicy); err != nil {
+		return err
+	}
+	if err := validateImage(workerImage, pullPolicy); err != nil {
+		return err
+	}
+
+	// Local benchmarks are built from a command package and run in-process
+	if local && pkgPath == "" {
+		return errors.New("--local requires a benchmark package")
+	}
+
+	// Checkpoints are stored in the cluster, so they're not supported by local benchmarks
+	if local && (checkpointInterval > 0 || resume != "") {
+		return errors.New("--checkpoint-interval and --resume are not supported with --local")
+	}
+	if workerBatchSize < 0 {
+		return errors.New("--worker-batch-size must not be negative")
+	}
+	if local && metricsPort > 0 {
+		return errors.New("--metrics-port is not supported with --local")
+	}
+
+	// If a report file was provided, convert the file to its absolute path
+	if reportFile != "" {
+		path, err := filepath.Abs(reportFile)
+		if err != nil {
+			return err
+		}
+		reportFile = path
+	}
 
 	// Generate a unique benchmark ID
 	benchID := random.NewPetName(2)
 
 	// If a command package was provided, build a binary and update the image tag
 	var executable string
-	if pkgPath != "" {
+	if pkgPath != "" && !local {
 		executable = filepath.Join(os.TempDir(), "helmit", benchID)
-		err := buildBinary(pkgPath, executable)
+		err := buildBinary(pkgPath, executable, rebuild)
 		if err != nil {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
@@ -164,6 +306,71 @@ func runBenchCommand(cmd *cobra.Command, args []string) error {
 		maxLatency = &d
 	}
 
+	var maxLatencyWindow *time.Duration
+	if cmd.Flags().Changed("max-latency-window") {
+		if maxLatency == nil {
+			return errors.New("--max-latency-window requires --max-latency")
+		}
+		d, _ := cmd.Flags().GetDuration("max-latency-window")
+		maxLatencyWindow = &d
+	}
+
+	workerArgs, err := parseWorkerArgs(workerArgsArray)
+	if err != nil {
+		return err
+	}
+
+	annotations, err := parsePodAnnotations(podAnnotations, annotationsMap)
+	if err != nil {
+		return err
+	}
+
+	if histogramPrecision < 1 || histogramPrecision > 5 {
+		return errors.New("--histogram-precision must be between 1 and 5")
+	}
+	if histogramMax <= 0 {
+		return errors.New("--histogram-max must be a positive duration")
+	}
+
+	if targetRate < 0 {
+		return errors.New("--target-rate must be a positive number of requests per second")
+	}
+
+	if workerPort < 1 || workerPort > 65535 {
+		return errors.New("--worker-port must be between 1 and 65535")
+	}
+
+	if warmup < 0 {
+		return errors.New("--warmup must be a positive number of requests")
+	}
+
+	var maxErrorRate *float64
+	if cmd.Flags().Changed("max-error-rate") {
+		percent, _ := cmd.Flags().GetFloat64("max-error-rate")
+		if percent < 0 || percent > 100 {
+			return errors.New("--max-error-rate must be between 0 and 100")
+		}
+		rate := percent / 100
+		maxErrorRate = &rate
+	}
+
+	percentiles := make([]float32, 0, len(percents))
+	for _, percent := range percents {
+		if percent <= 0 || percent >= 100 {
+			return fmt.Errorf("--percentiles must be between 0 and 100 exclusive, got %v", percent)
+		}
+		percentiles = append(percentiles, float32(float64(percent)/100))
+	}
+
+	var window *time.Duration
+	if cmd.Flags().Changed("window") {
+		d, _ := cmd.Flags().GetDuration("window")
+		if d <= 0 {
+			return errors.New("--window must be a positive duration")
+		}
+		window = &d
+	}
+
 	valueFiles, err := parseFiles(files)
 	if err != nil {
 		return err
@@ -174,6 +381,18 @@ func runBenchCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	stringValues, err := parseOverrides(setStrings)
+	if err != nil {
+		return err
+	}
+
+	// Validate the overrides against the values schemas of the context charts before the job is created
+	if context != "" {
+		if err := helm.ValidateValues(context, values, stringValues, valueFiles); err != nil {
+			return err
+		}
+	}
+
 	var d *time.Duration
 	if duration != 0 {
 		d = &duration
@@ -184,6 +403,20 @@ func runBenchCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	scrapeTargets := make([]benchmark.ScrapeTarget, 0, len(scrapes))
+	for _, scrape := range scrapes {
+		target, err := benchmark.ParseScrapeTarget(scrape)
+		if err != nil {
+			return err
+		}
+		scrapeTargets = append(scrapeTargets, target)
+	}
+	for _, metric := range scrapeMetrics {
+		if _, err := regexp.Compile(metric); err != nil {
+			return fmt.Errorf("invalid --scrape-metric %s: %v", metric, err)
+		}
+	}
+
 	config := &benchmark.Config{
 		Config: &job.Config{
 			ID:              benchID,
@@ -191,25 +424,114 @@ func runBenchCommand(cmd *cobra.Command, args []string) error {
 			ServiceAccount:  serviceAccount,
 			Labels:          labels,
 			Annotations:     annotations,
+			WaitContainers:  waitContainers,
 			Executable:      executable,
 			Image:           image,
 			ImagePullPolicy: pullPolicy,
 			Context:         context,
 			ValueFiles:      valueFiles,
 			Values:          values,
+			StringValues:    stringValues,
 			Timeout:         timeout,
 			NoTeardown:      noTeardown,
 			Secrets:         secrets,
 		},
-		Suite:       suite,
-		Benchmark:   benchmarkName,
-		Workers:     workers,
-		Parallelism: parallelism,
-		Iterations:  iterations,
-		Duration:    d,
-		Args:        benchArgs,
-		MaxLatency:  maxLatency,
-		NoTeardown:  noTeardown,
+		WorkerImage:        workerImage,
+		Suite:              suite,
+		Benchmark:          benchmarkName,
+		Workers:            workers,
+		Parallelism:        parallelism,
+		Iterations:         iterations,
+		Duration:           d,
+		Args:               benchArgs,
+		MaxLatency:         maxLatency,
+		MaxLatencyWindow:   maxLatencyWindow,
+		NoTeardown:         noTeardown,
+		Scrape:             scrapeTargets,
+		ScrapeMetrics:      scrapeMetrics,
+		KeepaliveTime:      keepaliveTime,
+		KeepaliveTimeout:   keepaliveTimeout,
+		Raw:                raw,
+		Format:             format,
+		ReportFile:         reportFile,
+		Columns:            columns,
+		Window:             window,
+		NoSetup:            noSetup,
+		WorkerArgs:         workerArgs,
+		CheckpointInterval: checkpointInterval,
+		Resume:             resume,
+		HeadlessWorkers:    headlessWorkers,
+		HistogramPrecision: histogramPrecision,
+		HistogramMax:       &histogramMax,
+		Percentiles:        percentiles,
+		NoProgress:         noProgress,
+		MetricsPort:        metricsPort,
+		MetricsLinger:      metricsLinger,
+		WorkerBatchSize:    workerBatchSize,
+		WorkerBatchDelay:   workerBatchDelay,
+		MinRequests:        minRequests,
+		MaxErrorRate:       maxErrorRate,
+		TargetRate:         targetRate,
+		Warmup:             warmup,
+		ContinueOnError:    continueOnError,
+		WorkerResults:      workerResults,
+		WorkerPort:         workerPort,
+	}
+	setRevision(config.Config, getRevision())
+	if local {
+		cmd.SilenceUsage = true
+		return runLocalBenchmark(pkgPath, config, rebuild)
 	}
 	return benchmark.Run(config)
 }
+
+// runLocalBenchmark builds the benchmark package for the local platform and runs the benchmarks in-process
+func runLocalBenchmark(pkgPath string, config *benchmark.Config, rebuild bool) error {
+	executable := filepath.Join(os.TempDir(), "helmit", random.NewPetName(2))
+	defer os.Remove(executable)
+	if err := buildBinaryFor(pkgPath, executable, runtime.GOOS, rebuild); err != nil {
+		return err
+	}
+
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(executable)
+	cmd.Env = append(os.Environ(),
+		benchmark.LocalConfigEnv+"="+string(bytes),
+		kubernetesconfig.NamespaceEnv+"="+config.Namespace,
+		job.RevisionEnv+"="+config.Env[job.RevisionEnv],
+		job.RevisionDirtyEnv+"="+config.Env[job.RevisionDirtyEnv])
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// parseWorkerArgs parses per-worker benchmark arguments in the format {worker}:{key}={value}
+func parseWorkerArgs(values []string) (map[int]map[string]string, error) {
+	workerArgs := make(map[int]map[string]string)
+	for _, value := range values {
+		index := strings.Index(value, ":")
+		if index == -1 {
+			return nil, fmt.Errorf("invalid worker argument %s: worker arguments must be in the format {worker}:{key}={value}", value)
+		}
+		worker, err := strconv.Atoi(value[:index])
+		if err != nil || worker < 0 {
+			return nil, fmt.Errorf("invalid worker argument %s: worker must be a non-negative integer", value)
+		}
+		arg := value[index+1:]
+		index = strings.Index(arg, "=")
+		if index == -1 {
+			return nil, fmt.Errorf("invalid worker argument %s: worker arguments must be in the format {worker}:{key}={value}", value)
+		}
+		args, ok := workerArgs[worker]
+		if !ok {
+			args = make(map[string]string)
+			workerArgs[worker] = args
+		}
+		args[arg[:index]] = arg[index+1:]
+	}
+	return workerArgs, nil
+}
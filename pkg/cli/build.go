@@ -0,0 +1,194 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+func buildBinary(pkgPath, binPath string, rebuild bool) error {
+	return buildBinaryFor(pkgPath, binPath, "linux", rebuild)
+}
+
+// buildBinaryFor builds the given command package for the given platform
+// Unless rebuild is true, a binary previously built from the same sources is reused from the build cache.
+func buildBinaryFor(pkgPath, binPath, goos string, rebuild bool) error {
+	workDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	pkg, err := build.Import(pkgPath, workDir, build.ImportComment)
+	if err != nil {
+		return err
+	}
+
+	if !pkg.IsCommand() {
+		return errors.New("test package must be a command")
+	}
+
+	// If the package's sources can't be hashed, fall back to building without the cache
+	cachePath, err := getCachedBinaryPath(pkgPath, goos)
+	if err != nil {
+		return runBuild(pkgPath, binPath, goos)
+	}
+
+	if !rebuild {
+		if _, err := os.Stat(cachePath); err == nil {
+			return copyBinary(cachePath, binPath)
+		}
+	}
+
+	if err := runBuild(pkgPath, binPath, goos); err != nil {
+		return err
+	}
+
+	// Write the binary to a temporary file and rename it to avoid concurrent builds reading partial binaries
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return nil
+	}
+	tmpPath := fmt.Sprintf("%s.%d", cachePath, os.Getpid())
+	if err := copyBinary(binPath, tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return nil
+	}
+	if err := os.Rename(tmpPath, cachePath); err != nil {
+		os.Remove(tmpPath)
+	}
+	return nil
+}
+
+// runBuild runs 'go build' for the given command package and platform
+func runBuild(pkgPath, binPath, goos string) error {
+	build := exec.Command("go", "build", "-o", binPath, pkgPath)
+	build.Stderr = os.Stderr
+	build.Stdout = os.Stdout
+	build.Env = buildEnv(goos)
+	return build.Run()
+}
+
+func buildEnv(goos string) []string {
+	env := os.Environ()
+	return append(env, "GOOS="+goos, "CGO_ENABLED=0")
+}
+
+// getCachedBinaryPath returns the path of the cached binary for the given command package and platform
+func getCachedBinaryPath(pkgPath, goos string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	hash, err := hashPackage(pkgPath, goos)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "helmit", "build", hash), nil
+}
+
+// listedPackage is the subset of the 'go list -json' output used to hash a package's sources
+type listedPackage struct {
+	ImportPath string
+	Dir        string
+	Standard   bool
+	GoFiles    []string
+	EmbedFiles []string
+	Module     *listedModule
+}
+
+type listedModule struct {
+	Path    string
+	Version string
+	Replace *listedModule
+}
+
+// hashPackage computes a hash of the sources from which the given command package is built
+// Dependencies in versioned modules are hashed by their module version. Packages in the main module or in
+// replaced modules are hashed by the contents of their source files.
+func hashPackage(pkgPath, goos string) (string, error) {
+	version, err := exec.Command("go", "version").Output()
+	if err != nil {
+		return "", err
+	}
+
+	list := exec.Command("go", "list", "-deps", "-json", pkgPath)
+	list.Env = buildEnv(goos)
+	output, err := list.Output()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s%s\n%s\n", version, goos, os.Getenv("GOFLAGS"))
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	for {
+		var pkg listedPackage
+		if err := decoder.Decode(&pkg); err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		if pkg.Standard {
+			continue
+		}
+		if pkg.Module != nil && pkg.Module.Version != "" && pkg.Module.Replace == nil {
+			fmt.Fprintf(hash, "%s@%s\n", pkg.ImportPath, pkg.Module.Version)
+			continue
+		}
+		fmt.Fprintf(hash, "%s\n", pkg.ImportPath)
+		files := append(append([]string{}, pkg.GoFiles...), pkg.EmbedFiles...)
+		for _, file := range files {
+			data, err := ioutil.ReadFile(filepath.Join(pkg.Dir, file))
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(hash, "%s %d\n", file, len(data))
+			hash.Write(data)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// copyBinary copies the executable at the source path to the destination path
+func copyBinary(srcPath, destPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return err
+	}
+	dest, err := os.OpenFile(destPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dest, src); err != nil {
+		dest.Close()
+		return err
+	}
+	return dest.Close()
+}
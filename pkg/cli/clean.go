@@ -0,0 +1,74 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onosproject/helmit/pkg/helm"
+	"github.com/onosproject/helmit/pkg/util/logging"
+	"github.com/spf13/cobra"
+)
+
+const cleanExamples = `
+  # Uninstall the releases left behind by tests of a commit in the default namespace.
+  helmit clean --selector revision=0123456789abcdef0123456789abcdef01234567
+
+  # Uninstall the releases whose names match a regular expression in a specific namespace.
+  helmit clean -n integration-tests --name '^kafka-.*'
+`
+
+func getCleanCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clean",
+		Short:   "Uninstall Helm releases left behind by tests and benchmarks",
+		Example: cleanExamples,
+		Args:    cobra.NoArgs,
+		RunE:    runCleanCommand,
+	}
+	cmd.Flags().StringP("namespace", "n", "default", "the namespace from which to uninstall releases")
+	cmd.Flags().String("name", "", "a regular expression matching the names of the releases to uninstall")
+	cmd.Flags().StringP("selector", "l", "", "a label selector matching the labels of the pods that installed the releases to uninstall")
+	cmd.Flags().Duration("timeout", 5*time.Minute, "the maximum time to wait for the releases to be uninstalled")
+	return cmd
+}
+
+func runCleanCommand(cmd *cobra.Command, args []string) error {
+	setupCommand(cmd)
+	namespace, _ := cmd.Flags().GetString("namespace")
+	name, _ := cmd.Flags().GetString("name")
+	selector, _ := cmd.Flags().GetString("selector")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	if name == "" && selector == "" {
+		return errors.New("a --name or --selector is required")
+	}
+
+	step := logging.NewStep(namespace, "Uninstall matching releases")
+	step.Start()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := helm.Client().Namespace(namespace).UninstallMatching(ctx, helm.ReleaseSelector{
+		Name:   name,
+		Labels: selector,
+	})
+	if err != nil {
+		step.Fail(err)
+		return err
+	}
+	step.Complete()
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// presetsFile is the name of the file in the context directory that defines the presets
+const presetsFile = "presets.yaml"
+
+// sliceValue is a flag value that holds a list of values, e.g. a string array
+type sliceValue interface {
+	Replace([]string) error
+	GetSlice() []string
+}
+
+// applyPreset applies the flags of the preset named by the --preset flag to the command
+// Presets are loaded from the presets file in the --context directory. Each preset maps flag names to values.
+// Flags set on the command line take precedence: scalar flags keep their command line value, and the command
+// line values of list and mapping flags, e.g. --set, are applied after the preset's values.
+func applyPreset(cmd *cobra.Command) error {
+	name, _ := cmd.Flags().GetString("preset")
+	if name == "" {
+		return nil
+	}
+	context, _ := cmd.Flags().GetString("context")
+	if context == "" {
+		return fmt.Errorf("--preset requires a --context containing a %s file", presetsFile)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(context, presetsFile))
+	if err != nil {
+		return fmt.Errorf("failed to read presets: %v", err)
+	}
+	presets := make(map[string]map[string]interface{})
+	if err := yaml.Unmarshal(data, &presets); err != nil {
+		return fmt.Errorf("failed to parse presets: %v", err)
+	}
+
+	preset, ok := presets[name]
+	if !ok {
+		names := make([]string, 0, len(presets))
+		for name := range presets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown preset %s: available presets are %s", name, strings.Join(names, ", "))
+	}
+
+	flags := make([]string, 0, len(preset))
+	for flag := range preset {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	for _, name := range flags {
+		if err := applyPresetFlag(cmd, name, preset[name]); err != nil {
+			return fmt.Errorf("invalid preset flag %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// applyPresetFlag applies a single preset value to the named flag of the command
+func applyPresetFlag(cmd *cobra.Command, name string, value interface{}) error {
+	if name == "preset" || name == "context" {
+		return fmt.Errorf("flag cannot be set by a preset")
+	}
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil {
+		return fmt.Errorf("unknown flag")
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		slice, ok := flag.Value.(sliceValue)
+		if !ok {
+			return fmt.Errorf("flag does not accept a list")
+		}
+		values := make([]string, 0, len(v)+len(slice.GetSlice()))
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		if flag.Changed {
+			values = append(values, slice.GetSlice()...)
+		}
+		return slice.Replace(values)
+	case map[interface{}]interface{}:
+		if flag.Value.Type() != "stringToString" {
+			return fmt.Errorf("flag does not accept a mapping")
+		}
+		explicit, _ := cmd.Flags().GetStringToString(name)
+		if err := flag.Value.Set(joinPairs(v)); err != nil {
+			return err
+		}
+		if flag.Changed && len(explicit) > 0 {
+			pairs := make(map[interface{}]interface{}, len(explicit))
+			for key, value := range explicit {
+				pairs[key] = value
+			}
+			return flag.Value.Set(joinPairs(pairs))
+		}
+		return nil
+	default:
+		if flag.Changed {
+			return nil
+		}
+		if slice, ok := flag.Value.(sliceValue); ok {
+			return slice.Replace([]string{fmt.Sprint(v)})
+		}
+		return flag.Value.Set(fmt.Sprint(v))
+	}
+}
+
+// joinPairs joins the given mapping into the {key}={value} list format of mapping flags
+func joinPairs(values map[interface{}]interface{}) string {
+	pairs := make([]string, 0, len(values))
+	for key, value := range values {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", key, value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
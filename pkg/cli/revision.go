@@ -0,0 +1,65 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/onosproject/helmit/pkg/job"
+)
+
+// getRevision returns the git revision of the working directory
+// If the working directory is not in a git repository, the revision's commit is job.UnknownRevision.
+func getRevision() job.Revision {
+	commit, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return job.Revision{Commit: job.UnknownRevision}
+	}
+	status, err := exec.Command("git", "status", "--porcelain").Output()
+	if err != nil {
+		return job.Revision{Commit: job.UnknownRevision}
+	}
+	return job.Revision{
+		Commit: strings.TrimSpace(string(commit)),
+		Dirty:  len(strings.TrimSpace(string(status))) > 0,
+	}
+}
+
+// setRevision records the given revision in the job's labels and environment
+// Labels set by the user take precedence over the revision labels.
+func setRevision(config *job.Config, revision job.Revision) {
+	dirty := strconv.FormatBool(revision.Dirty)
+	if revision.Commit == job.UnknownRevision {
+		dirty = job.UnknownRevision
+	}
+
+	if config.Labels == nil {
+		config.Labels = make(map[string]string)
+	}
+	if _, ok := config.Labels[job.RevisionLabel]; !ok {
+		config.Labels[job.RevisionLabel] = revision.Commit
+	}
+	if _, ok := config.Labels[job.RevisionDirtyLabel]; !ok {
+		config.Labels[job.RevisionDirtyLabel] = dirty
+	}
+
+	if config.Env == nil {
+		config.Env = make(map[string]string)
+	}
+	config.Env[job.RevisionEnv] = revision.Commit
+	config.Env[job.RevisionDirtyEnv] = dirty
+}
@@ -39,7 +39,9 @@ func GetRootCommand() *cobra.Command {
 	cmd.AddCommand(getTestCommand())
 	cmd.AddCommand(getBenchCommand())
 	cmd.AddCommand(getSimulateCommand())
+	cmd.AddCommand(getCleanCommand())
 	cmd.PersistentFlags().BoolP("verbose", "v", false, "Enable verbose output")
+	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output (also disabled by setting NO_COLOR)")
 	return cmd
 }
 
@@ -57,4 +59,6 @@ func GenerateCliDocs() {
 func setupCommand(cmd *cobra.Command) {
 	verbose, _ := cmd.Flags().GetBool("verbose")
 	logging.SetVerbose(verbose)
+	noColor, _ := cmd.Flags().GetBool("no-color")
+	logging.SetNoColor(noColor)
 }
@@ -67,6 +67,7 @@ func getSimulateCommand() *cobra.Command {
 	cmd.Flags().DurationP("duration", "d", 10*time.Minute, "the duration for which to run the simulation")
 	cmd.Flags().StringToStringP("args", "a", map[string]string{}, "a mapping of named simulation arguments")
 	cmd.Flags().StringToStringP("schedule", "r", map[string]string{}, "a mapping of operations to schedule")
+	cmd.Flags().Bool("rebuild", false, "rebuild the simulation package even if an unchanged build is cached")
 	return cmd
 }
 
@@ -91,12 +92,16 @@ func runSimulateCommand(cmd *cobra.Command, args []string) error {
 	simArgs, _ := cmd.Flags().GetStringToString("args")
 	operations, _ := cmd.Flags().GetStringToString("schedule")
 	imagePullPolicy, _ := cmd.Flags().GetString("image-pull-policy")
+	rebuild, _ := cmd.Flags().GetBool("rebuild")
 	pullPolicy := corev1.PullPolicy(imagePullPolicy)
 
 	// Either a command package or image must be specified
 	if pkgPath == "" && image == "" {
 		return errors.New("must specify either a simulation package or --image to run")
 	}
+	if err := validateImage(image, pullPolicy); err != nil {
+		return err
+	}
 
 	// Generate a unique simulation ID
 	simID := random.NewPetName(2)
@@ -105,7 +110,7 @@ func runSimulateCommand(cmd *cobra.Command, args []string) error {
 	var executable string
 	if pkgPath != "" {
 		executable = filepath.Join(os.TempDir(), "helmit", simID)
-		err := buildBinary(pkgPath, executable)
+		err := buildBinary(pkgPath, executable, rebuild)
 		if err != nil {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
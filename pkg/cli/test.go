@@ -16,14 +16,17 @@ package cli
 
 import (
 	"errors"
-	"go/build"
+	"fmt"
 	"math/rand"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"runtime"
 	"strings"
 	"time"
 
+	"github.com/onosproject/helmit/pkg/helm"
 	"github.com/onosproject/helmit/pkg/job"
 
 	"github.com/onosproject/helmit/pkg/util/logging"
@@ -42,6 +45,18 @@ const testExamples = `
   # Run tests packaged in a Docker image.
   helmit test --image atomix/kubernetes-tests:latest
 
+  # Run the tests in several images as separate jobs and print a combined summary.
+  helmit test --image atomix/kubernetes-tests:latest --image onosproject/onos-tests:latest
+
+  # Add annotations required by admission controllers to every test pod.
+  helmit test ./cmd/tests --pod-annotation cost-center=platform --pod-annotation sidecar.istio.io/inject=false
+
+  # Wait for an injected service mesh proxy to be ready before starting the tests.
+  helmit test ./cmd/tests --wait-container istio-proxy
+
+  # Run tests in an image pinned by digest so every pod runs the exact same image.
+  helmit test --image atomix/kubernetes-tests@sha256:<digest>
+
   # Run tests by referencing a command package and providing a context.
   # The specified context will be loaded into the test pod as the current working directory.
   helmit test ./cmd/tests --context ./charts
@@ -55,6 +70,12 @@ const testExamples = `
   # Run a single test by name.
   helmit test ./cmd/tests -c ./charts --suite atomix --test TestMap
 
+  # Pass custom flags declared by test suites following "--".
+  helmit test ./cmd/tests -c ./charts --suite atomix -- --replicas=3
+
+  # List the custom flags declared by test suites.
+  helmit test ./cmd/tests -- --help
+
   # Override Helm chart values with flags.
   # Value overrids must be namespaced with the name of the release to which to apply the value.
   helmit test ./cmd/tests -c ./charts --set atomix-controller.image=atomix/atomix-controller:latest --set atomix-raft.replicas=3 --suite atomix
@@ -70,29 +91,63 @@ func getTestCommand() *cobra.Command {
 		Aliases: []string{"tests"},
 		Short:   "Run tests on Kubernetes",
 		Example: testExamples,
-		Args:    cobra.MaximumNArgs(1),
+		Args:    validateTestArgs,
 		RunE:    runTestCommand,
 	}
 	cmd.Flags().StringP("namespace", "n", "default", "the namespace in which to run the tests")
 	cmd.Flags().String("service-account", "", "the name of the service account to use to run test pods")
 	cmd.Flags().StringP("context", "c", "", "the test context")
-	cmd.Flags().StringP("image", "i", "", "the test image to run")
+	cmd.Flags().String("preset", "", "the name of a preset of flags to apply from the presets.yaml file in the test context")
+	cmd.Flags().StringArrayP("image", "i", []string{}, "the test image to run; may be repeated to run the tests in each image as a separate job")
 	cmd.Flags().String("image-pull-policy", string(corev1.PullIfNotPresent), "the Docker image pull policy")
+	cmd.Flags().Bool("capture-output", false, "tag each line of test output with its stream and test name and print stderr output to stderr")
+	cmd.Flags().StringArray("wait-container", []string{}, "the name of a container, e.g. an injected sidecar, that must be ready in the test pods before tests are started")
+	cmd.Flags().StringArray("pod-annotation", []string{}, "an annotation to add to the test coordinator and worker pods in the format {key}={value}")
 	cmd.Flags().StringArrayP("values", "f", []string{}, "release values paths")
 	cmd.Flags().StringArray("set", []string{}, "chart value overrides")
+	cmd.Flags().StringArray("set-string", []string{}, "chart value overrides whose values are always strings")
 	cmd.Flags().StringSliceP("suite", "s", []string{}, "the name of test suite to run")
 	cmd.Flags().StringSliceP("test", "t", []string{}, "the name of the test method to run")
 	cmd.Flags().Duration("timeout", 10*time.Minute, "test timeout")
+	cmd.Flags().Duration("settle", 0, "the time to wait for the cluster to converge after suite and test setup and in calls to Settle")
+	cmd.Flags().Int("shards", 1, "the number of worker pods across which to shard the tests in each suite")
+	cmd.Flags().Int("max-concurrent-pods", 0, "the maximum number of test worker pods to run at once; additional shards wait for earlier shards to complete")
+	cmd.Flags().Bool("restrict-namespace", false, "fail Kubernetes API requests made by tests that target namespaces other than the test namespace")
+	cmd.Flags().String("trace-requests", "", "trace the Kubernetes API requests made by tests to 'stdout' or to a local file")
+	cmd.Flags().String("artifacts-dir", "", "the directory in the test pod to which a snapshot of the namespace is written when a test fails")
+	cmd.Flags().String("snapshot-file", "", "a local file to which a snapshot of the namespace is written when a test fails")
+	cmd.Flags().Duration("hold", 0, "hold the test namespace open with a pause pod for the given duration after the tests complete")
+	cmd.Flags().Duration("test-timeout", 0, "the maximum duration of each test method")
 	cmd.Flags().Int("iterations", 1, "number of iterations")
 	cmd.Flags().Bool("until-failure", false, "run until an error is detected")
 	cmd.Flags().Bool("no-teardown", false, "do not tear down clusters following tests")
+	cmd.Flags().Bool("rebuild", false, "rebuild the test package even if an unchanged build is cached")
 	cmd.Flags().StringSlice("secret", []string{}, "secrets to pass to the kubernetes pod")
+	cmd.Flags().String("pre-command", "", "a shell command to run in the test context before the suites are run")
+	cmd.Flags().Bool("require-clean", false, "fail the tests if the namespace contains resources before the suite is set up")
+	cmd.Flags().String("verify-teardown", "", "compare the namespace after each suite is torn down with its state before the suite and 'warn' or 'fail' if resources were left behind")
 	cmd.Flags().StringToStringP("args", "a", map[string]string{}, "a mapping of named test arguments")
 	return cmd
 }
 
+// validateTestArgs allows a single test package argument followed by suite flags after "--"
+func validateTestArgs(cmd *cobra.Command, args []string) error {
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		args = args[:dash]
+	}
+	return cobra.MaximumNArgs(1)(cmd, args)
+}
+
 func runTestCommand(cmd *cobra.Command, args []string) error {
 	setupCommand(cmd)
+	if err := applyPreset(cmd); err != nil {
+		return err
+	}
+	var suiteFlags []string
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		args, suiteFlags = args[:dash], args[dash:]
+	}
+
 	pkgPath := ""
 	if len(args) > 0 {
 		pkgPath = args[0]
@@ -101,23 +156,63 @@ func runTestCommand(cmd *cobra.Command, args []string) error {
 	namespace, _ := cmd.Flags().GetString("namespace")
 	serviceAccount, _ := cmd.Flags().GetString("service-account")
 	context, _ := cmd.Flags().GetString("context")
-	image, _ := cmd.Flags().GetString("image")
+	images, _ := cmd.Flags().GetStringArray("image")
 	files, _ := cmd.Flags().GetStringArray("values")
 	sets, _ := cmd.Flags().GetStringArray("set")
+	setStrings, _ := cmd.Flags().GetStringArray("set-string")
 	suites, _ := cmd.Flags().GetStringSlice("suite")
 	testNames, _ := cmd.Flags().GetStringSlice("test")
 	timeout, _ := cmd.Flags().GetDuration("timeout")
+	testTimeout, _ := cmd.Flags().GetDuration("test-timeout")
+	hold, _ := cmd.Flags().GetDuration("hold")
+	shards, _ := cmd.Flags().GetInt("shards")
+	maxConcurrentPods, _ := cmd.Flags().GetInt("max-concurrent-pods")
+	settle, _ := cmd.Flags().GetDuration("settle")
+	artifactsDir, _ := cmd.Flags().GetString("artifacts-dir")
+	snapshotFile, _ := cmd.Flags().GetString("snapshot-file")
+	restrictNamespace, _ := cmd.Flags().GetBool("restrict-namespace")
+	traceRequests, _ := cmd.Flags().GetString("trace-requests")
 	pullPolicy, _ := cmd.Flags().GetString("image-pull-policy")
 	iterations, _ := cmd.Flags().GetInt("iterations")
 	untilFailure, _ := cmd.Flags().GetBool("until-failure")
 	noTeardown, _ := cmd.Flags().GetBool("no-teardown")
+	rebuild, _ := cmd.Flags().GetBool("rebuild")
 	secretsArray, _ := cmd.Flags().GetStringSlice("secret")
 	testArgs, _ := cmd.Flags().GetStringToString("args")
+	requireClean, _ := cmd.Flags().GetBool("require-clean")
+	preCommand, _ := cmd.Flags().GetString("pre-command")
+	podAnnotations, _ := cmd.Flags().GetStringArray("pod-annotation")
+	waitContainers, _ := cmd.Flags().GetStringArray("wait-container")
+	captureOutput, _ := cmd.Flags().GetBool("capture-output")
+	verifyTeardown, _ := cmd.Flags().GetString("verify-teardown")
 
 	// Either a command package or image must be specified
-	if pkgPath == "" && image == "" {
+	if pkgPath == "" && len(images) == 0 {
 		return errors.New("must specify either a test package or --image to run")
 	}
+	if pkgPath != "" && len(images) > 1 {
+		return errors.New("--image can only be specified once when running a test package")
+	}
+	if verifyTeardown != "" && verifyTeardown != test.VerifyTeardownWarn && verifyTeardown != test.VerifyTeardownFail {
+		return fmt.Errorf("unknown --verify-teardown '%s': must be 'warn' or 'fail'", verifyTeardown)
+	}
+	for _, image := range images {
+		if err := validateImage(image, corev1.PullPolicy(pullPolicy)); err != nil {
+			return err
+		}
+	}
+	var image string
+	if len(images) > 0 {
+		image = images[0]
+	}
+
+	// If suite flag help was requested, print the flags declared by the test package
+	if isHelpRequested(suiteFlags) {
+		if pkgPath == "" {
+			return errors.New("suite flags can only be listed for a test package")
+		}
+		return printSuiteFlags(pkgPath)
+	}
 
 	// Generate a unique test ID
 	testID := random.NewPetName(2)
@@ -126,7 +221,7 @@ func runTestCommand(cmd *cobra.Command, args []string) error {
 	var executable string
 	if pkgPath != "" {
 		executable = filepath.Join(os.TempDir(), "helmit", testID)
-		err := buildBinary(pkgPath, executable)
+		err := buildBinary(pkgPath, executable, rebuild)
 		if err != nil {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
@@ -160,59 +255,139 @@ func runTestCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	stringValues, err := parseOverrides(setStrings)
+	if err != nil {
+		return err
+	}
+
+	// Validate the overrides against the values schemas of the context charts before the job is created
+	if context != "" {
+		if err := helm.ValidateValues(context, values, stringValues, valueFiles); err != nil {
+			return err
+		}
+	}
+
 	secrets, err := parseSecrets(secretsArray)
 	if err != nil {
 		return err
 	}
 
+	annotations, err := parsePodAnnotations(podAnnotations, nil)
+	if err != nil {
+		return err
+	}
+
 	config := &test.Config{
 		Config: &job.Config{
 			ID:              testID,
 			ServiceAccount:  serviceAccount,
 			Namespace:       namespace,
+			Annotations:     annotations,
+			WaitContainers:  waitContainers,
 			Image:           image,
 			ImagePullPolicy: corev1.PullPolicy(pullPolicy),
 			Executable:      executable,
 			Context:         context,
 			ValueFiles:      valueFiles,
 			Values:          values,
+			StringValues:    stringValues,
 			Timeout:         timeout,
 			NoTeardown:      noTeardown,
 			Secrets:         secrets,
+			Hold:            hold,
 		},
-		Suites:     suites,
-		Tests:      testNames,
-		Iterations: iterations,
-		Verbose:    logging.GetVerbose(),
-		NoTeardown: noTeardown,
-		Args:       testArgs,
+		Suites:            suites,
+		Tests:             testNames,
+		Iterations:        iterations,
+		Verbose:           logging.GetVerbose(),
+		NoTeardown:        noTeardown,
+		Args:              testArgs,
+		Flags:             suiteFlags,
+		RequireClean:      requireClean,
+		PreCommand:        preCommand,
+		TestTimeout:       testTimeout,
+		Shards:            shards,
+		ArtifactsDir:      artifactsDir,
+		SnapshotFile:      snapshotFile,
+		RestrictNamespace: restrictNamespace,
+		TraceRequests:     traceRequests,
+		Settle:            settle,
+		CaptureOutput:     captureOutput,
+		VerifyTeardown:    verifyTeardown,
+		MaxConcurrentPods: maxConcurrentPods,
+	}
+	setRevision(config.Config, getRevision())
+	if len(images) > 1 {
+		os.Exit(test.RunImages(newImageConfigs(config, images)))
 	}
 	return test.Run(config)
 }
 
-func buildBinary(pkgPath, binPath string) error {
-	workDir, err := os.Getwd()
-	if err != nil {
-		return err
+// newImageConfigs returns a copy of the given test configuration for each image
+// Each copy is given a unique ID so the jobs for the images don't conflict, and local output files are
+// suffixed with the index of the image so the outputs of the images don't overwrite each other.
+func newImageConfigs(config *test.Config, images []string) []*test.Config {
+	configs := make([]*test.Config, len(images))
+	for i, image := range images {
+		jobConfig := *config.Config
+		jobConfig.ID = fmt.Sprintf("%s-%d", config.ID, i)
+		jobConfig.Image = image
+		jobConfig.Labels = copyStringMap(config.Labels)
+		jobConfig.Annotations = copyStringMap(config.Annotations)
+		jobConfig.Env = copyStringMap(config.Env)
+		imageConfig := *config
+		imageConfig.Config = &jobConfig
+		if config.SnapshotFile != "" {
+			imageConfig.SnapshotFile = getImageFile(config.SnapshotFile, i)
+		}
+		if config.TraceRequests != "" && config.TraceRequests != "stdout" {
+			imageConfig.TraceRequests = getImageFile(config.TraceRequests, i)
+		}
+		configs[i] = &imageConfig
 	}
+	return configs
+}
 
-	pkg, err := build.Import(pkgPath, workDir, build.ImportComment)
-	if err != nil {
-		return err
+// getImageFile returns the given local file suffixed with the given image index, e.g. snapshot-1.txt
+func getImageFile(file string, index int) string {
+	ext := filepath.Ext(file)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(file, ext), index, ext)
+}
+
+// copyStringMap returns a copy of the given map
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(m))
+	for key, value := range m {
+		copied[key] = value
 	}
+	return copied
+}
 
-	if !pkg.IsCommand() {
-		return errors.New("test package must be a command")
+// isHelpRequested returns whether help was requested in the given suite flags
+func isHelpRequested(flags []string) bool {
+	for _, flag := range flags {
+		if flag == "-h" || flag == "-help" || flag == "--help" {
+			return true
+		}
 	}
+	return false
+}
 
-	// Build the command
-	build := exec.Command("go", "build", "-o", binPath, pkgPath)
-	build.Stderr = os.Stderr
-	build.Stdout = os.Stdout
-	env := os.Environ()
-	env = append(env, "GOOS=linux", "CGO_ENABLED=0")
-	build.Env = env
-	return build.Run()
+// printSuiteFlags builds the test package for the local platform and prints the suite flags it declares
+func printSuiteFlags(pkgPath string) error {
+	executable := filepath.Join(os.TempDir(), "helmit", random.NewPetName(2))
+	defer os.Remove(executable)
+	if err := buildBinaryFor(pkgPath, executable, runtime.GOOS, false); err != nil {
+		return err
+	}
+	cmd := exec.Command(executable)
+	cmd.Env = append(os.Environ(), test.PrintFlagsEnv+"=true")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
 }
 
 func parseFiles(files []string) (map[string][]string, error) {
@@ -261,6 +436,22 @@ func parseOverrides(values []string) (map[string][]string, error) {
 	return overrides, nil
 }
 
+// parsePodAnnotations parses pod annotations in the format {key}={value} and merges them into the given annotations
+func parsePodAnnotations(values []string, annotations map[string]string) (map[string]string, error) {
+	merged := make(map[string]string)
+	for key, value := range annotations {
+		merged[key] = value
+	}
+	for _, annotation := range values {
+		index := strings.Index(annotation, "=")
+		if index <= 0 {
+			return nil, fmt.Errorf("invalid pod annotation %s: annotations must be in the format {key}={value}", annotation)
+		}
+		merged[annotation[:index]] = annotation[index+1:]
+	}
+	return merged, nil
+}
+
 func parseSecrets(secrets []string) (map[string]string, error) {
 	if len(secrets) == 0 {
 		return map[string]string{}, nil
@@ -277,3 +468,24 @@ func parseSecrets(secrets []string) (map[string]string, error) {
 	}
 	return values, nil
 }
+
+var imageDigestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// validateImage validates the given image reference
+// Digest references must be well formed. A warning is printed if the image is referenced by a mutable tag
+// and the pull policy may use a stale image cached on a node.
+func validateImage(image string, pullPolicy corev1.PullPolicy) error {
+	if image == "" {
+		return nil
+	}
+	if index := strings.Index(image, "@"); index != -1 {
+		if !imageDigestRegex.MatchString(image[index+1:]) {
+			return fmt.Errorf("invalid image digest in %s: digests must be in the format sha256:{hex}", image)
+		}
+		return nil
+	}
+	if pullPolicy != corev1.PullAlways {
+		fmt.Fprintf(os.Stderr, "Warning: image %s is referenced by a mutable tag with pull policy %s; pin the image by digest (name@sha256:...) or use --image-pull-policy=%s to avoid running a stale cached image\n", image, pullPolicy, corev1.PullAlways)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParsePodAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []string
+		annotations map[string]string
+		expected    map[string]string
+		fails       bool
+	}{
+		{
+			name:     "no annotations",
+			expected: map[string]string{},
+		},
+		{
+			name:     "annotations",
+			values:   []string{"cost-center=platform", "sidecar.istio.io/inject=false"},
+			expected: map[string]string{"cost-center": "platform", "sidecar.istio.io/inject": "false"},
+		},
+		{
+			name:     "values containing separators",
+			values:   []string{"selector=app=kafka", "empty="},
+			expected: map[string]string{"selector": "app=kafka", "empty": ""},
+		},
+		{
+			name:        "merged with existing annotations",
+			values:      []string{"team=onos", "owner=ci"},
+			annotations: map[string]string{"owner": "dev", "tier": "test"},
+			expected:    map[string]string{"team": "onos", "owner": "ci", "tier": "test"},
+		},
+		{
+			name:   "missing separator",
+			values: []string{"cost-center"},
+			fails:  true,
+		},
+		{
+			name:   "missing key",
+			values: []string{"=platform"},
+			fails:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			annotations, err := parsePodAnnotations(test.values, test.annotations)
+			if test.fails {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, annotations)
+		})
+	}
+}
+
+func TestParsePodAnnotationsCopy(t *testing.T) {
+	annotations := map[string]string{"owner": "dev"}
+	_, err := parsePodAnnotations([]string{"owner=ci"}, annotations)
+	assert.NoError(t, err)
+	assert.Equal(t, "dev", annotations["owner"])
+}
+
+func TestValidateImage(t *testing.T) {
+	tests := []struct {
+		name       string
+		image      string
+		pullPolicy corev1.PullPolicy
+		fails      bool
+	}{
+		{name: "no image", image: ""},
+		{name: "tag", image: "atomix/kubernetes-tests:latest", pullPolicy: corev1.PullIfNotPresent},
+		{name: "tag always pulled", image: "atomix/kubernetes-tests:latest", pullPolicy: corev1.PullAlways},
+		{name: "digest", image: "atomix/kubernetes-tests@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", pullPolicy: corev1.PullIfNotPresent},
+		{name: "short digest", image: "atomix/kubernetes-tests@sha256:abc", fails: true},
+		{name: "unsupported digest algorithm", image: "atomix/kubernetes-tests@md5:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", fails: true},
+		{name: "uppercase digest", image: "atomix/kubernetes-tests@sha256:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateImage(test.image, test.pullPolicy)
+			if test.fails {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
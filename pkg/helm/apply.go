@@ -0,0 +1,153 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	helm "helm.sh/helm/v3/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/resource"
+)
+
+// defaultFieldManager is the field manager used for dry runs when the context does not specify one
+const defaultFieldManager = "helmit"
+
+// applyClient is a Helm Kubernetes client that creates and updates resources using server-side apply
+// when the context specifies a field manager
+type applyClient struct {
+	helm.Interface
+}
+
+// Create creates the given resources
+func (c *applyClient) Create(resources helm.ResourceList) (*helm.Result, error) {
+	if context.FieldManager == "" {
+		return c.Interface.Create(resources)
+	}
+	if err := apply(resources, context.FieldManager, false); err != nil {
+		return nil, err
+	}
+	return &helm.Result{Created: resources}, nil
+}
+
+// Update updates the given resources
+// When the context specifies a field manager, the target resources are applied using server-side apply and
+// resources in the original resources that are not in the target are deleted, as they are by Helm's client.
+func (c *applyClient) Update(original, target helm.ResourceList, force bool) (*helm.Result, error) {
+	if context.FieldManager == "" {
+		return c.Interface.Update(original, target, force)
+	}
+	if err := apply(target, context.FieldManager, false); err != nil {
+		return nil, err
+	}
+
+	result := &helm.Result{}
+	for _, info := range target {
+		if original.Contains(info) {
+			result.Updated = append(result.Updated, info)
+		} else {
+			result.Created = append(result.Created, info)
+		}
+	}
+	if deleted := original.Difference(target); len(deleted) > 0 {
+		// As with Helm's client, failures to delete resources removed from the release are not fatal
+		deleteResult, _ := c.Interface.Delete(deleted)
+		if deleteResult != nil {
+			result.Deleted = deleteResult.Deleted
+		}
+	}
+	return result, nil
+}
+
+// apply applies the given resources using server-side apply with the given field manager
+// Errors returned by the API server, e.g. admission webhook rejections and conflicts with fields owned by other
+// field managers, are returned verbatim. Conflicting fields are only taken over if the context forces conflicts.
+func apply(resources helm.ResourceList, fieldManager string, dryRun bool) error {
+	return resources.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+		if err != nil {
+			return err
+		}
+		apiVersion, kind := info.Mapping.GroupVersionKind.ToAPIVersionAndKind()
+		object["apiVersion"] = apiVersion
+		object["kind"] = kind
+		data, err := json.Marshal(object)
+		if err != nil {
+			return err
+		}
+
+		force := context.ForceConflicts
+		options := &metav1.PatchOptions{
+			FieldManager: fieldManager,
+			Force:        &force,
+		}
+		if dryRun {
+			options.DryRun = []string{metav1.DryRunAll}
+		}
+		obj, err := resource.NewHelper(info.Client, info.Mapping).Patch(info.Namespace, info.Name, types.ApplyPatchType, data, options)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", kind, info.Name, err)
+		}
+		if dryRun {
+			return nil
+		}
+		return info.Refresh(obj, true)
+	})
+}
+
+// DryRun performs a server-side dry-run install of the release
+// The chart is rendered and each resource is submitted to the API server with dry-run enabled, catching
+// validation and admission errors that client-side templating misses. No resources are persisted.
+func (r *HelmRelease) DryRun() error {
+	if err := r.setContextDir(); err != nil {
+		return err
+	}
+
+	install := r.newInstall()
+	install.DryRun = true
+	chart, err := r.loadChart(install)
+	if err != nil {
+		return err
+	}
+
+	values, err := r.installValues()
+	if err != nil {
+		return err
+	}
+
+	release, err := install.Run(chart, values)
+	if err != nil {
+		return err
+	}
+
+	resources, err := r.config.KubeClient.Build(bytes.NewBufferString(release.Manifest), true)
+	if err != nil {
+		return err
+	}
+
+	fieldManager := context.FieldManager
+	if fieldManager == "" {
+		fieldManager = defaultFieldManager
+	}
+	return apply(resources, fieldManager, true)
+}
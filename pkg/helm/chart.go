@@ -16,6 +16,8 @@
 package helm
 
 import (
+	"time"
+
 	"helm.sh/helm/v3/pkg/action"
 	"k8s.io/client-go/kubernetes"
 )
@@ -57,12 +59,19 @@ func newChart(name string, repo []string, namespace string, client *kubernetes.C
 // HelmChart is a Helm chart
 type HelmChart struct {
 	HelmReleaseClient
-	namespace  string
-	client     *kubernetes.Clientset
-	config     *action.Configuration
-	name       string
-	repository string
-	releases   map[string]*HelmRelease
+	namespace        string
+	client           *kubernetes.Clientset
+	config           *action.Configuration
+	name             string
+	repository       string
+	version          string
+	releases         map[string]*HelmRelease
+	shared           bool
+	dependencyUpdate bool
+	skipCRDs         bool
+	readiness        *Readiness
+	timeout          time.Duration
+	dependencies     []*HelmChart
 }
 
 // Name returns the chart name
@@ -75,6 +84,93 @@ func (c *HelmChart) Repository() string {
 	return c.repository
 }
 
+// SetVersion pins the version of the chart to install
+// If the version is not found in the chart's repository, installing a release of the chart fails.
+func (c *HelmChart) SetVersion(version string) *HelmChart {
+	c.version = version
+	return c
+}
+
+// Version returns the pinned version of the chart, or an empty string if the latest version is installed
+func (c *HelmChart) Version() string {
+	return c.version
+}
+
+// SetShared sets whether releases of the chart are shared across suites
+// Shared releases are installed once and are not uninstalled by Uninstall. Instead, they're
+// uninstalled by TearDownSharedReleases once all suites have completed.
+func (c *HelmChart) SetShared(shared bool) *HelmChart {
+	c.shared = shared
+	return c
+}
+
+// Shared returns whether releases of the chart are shared across suites
+func (c *HelmChart) Shared() bool {
+	return c.shared
+}
+
+// SetSkipCRDs sets whether to skip installing the chart's CRDs in all releases of the chart
+// Use this when the CRDs are installed separately, e.g. cluster-wide before the tests are run.
+func (c *HelmChart) SetSkipCRDs(skipCRDs bool) *HelmChart {
+	c.skipCRDs = skipCRDs
+	return c
+}
+
+// SkipCRDs returns whether the chart's CRDs are skipped in all releases of the chart
+func (c *HelmChart) SkipCRDs() bool {
+	return c.skipCRDs
+}
+
+// SetDependencyUpdate sets whether to update the chart's dependencies before installing it
+// If the dependencies declared in the chart's Chart.yaml are missing from its charts directory, they're
+// downloaded from the repositories referenced by the chart, as with `helm dependency update`.
+func (c *HelmChart) SetDependencyUpdate(update bool) *HelmChart {
+	c.dependencyUpdate = update
+	return c
+}
+
+// DependencyUpdate returns whether the chart's dependencies are updated before it's installed
+func (c *HelmChart) DependencyUpdate() bool {
+	return c.dependencyUpdate
+}
+
+// ReadyWhen sets the readiness criteria enforced when a release of the chart is installed or upgraded with wait
+// Once Helm's wait completes, the release's workloads are polled until they satisfy the given criteria or the
+// release's timeout expires.
+func (c *HelmChart) ReadyWhen(readiness Readiness) *HelmChart {
+	c.readiness = &readiness
+	return c
+}
+
+// Readiness returns the chart's readiness criteria, or nil if only Helm's own wait is used
+func (c *HelmChart) Readiness() *Readiness {
+	return c.readiness
+}
+
+// SetTimeout sets the maximum time to wait for releases of the chart to be installed, upgraded, or rolled back
+// The timeout applies to all releases of the chart that do not set their own timeout with WithTimeout.
+func (c *HelmChart) SetTimeout(timeout time.Duration) *HelmChart {
+	c.timeout = timeout
+	return c
+}
+
+// Timeout returns the chart's wait timeout, or zero if Helm's default timeout is used
+func (c *HelmChart) Timeout() time.Duration {
+	return c.timeout
+}
+
+// DependsOn declares that the chart's releases must be installed after the releases of the given charts
+// Dependencies are respected by InstallGraph.
+func (c *HelmChart) DependsOn(charts ...*HelmChart) *HelmChart {
+	c.dependencies = append(c.dependencies, charts...)
+	return c
+}
+
+// Dependencies returns the charts on which the chart depends
+func (c *HelmChart) Dependencies() []*HelmChart {
+	return c.dependencies
+}
+
 // Releases returns a list of releases of the chart
 func (c *HelmChart) Releases() []*HelmRelease {
 	releases := make([]*HelmRelease, 0, len(c.releases))
@@ -16,15 +16,28 @@
 package helm
 
 import (
+	gocontext "context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/onosproject/helmit/pkg/kubernetes/config"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
 )
 
 var clients = make(map[string]HelmClient)
 
+func init() {
+	// Apply the same guardrails to Helm's Kubernetes clients as to the Kubernetes client
+	if flags, ok := settings.RESTClientGetter().(*genericclioptions.ConfigFlags); ok {
+		flags.WrapConfigFn = config.WrapConfig
+	}
+}
+
 // Namespace returns the Helm namespace
 func Namespace() string {
 	return config.GetNamespaceFromEnv()
@@ -55,11 +68,25 @@ func getClient(namespace string) HelmClient {
 }
 
 // getConfig gets the Helm configuration for the given namespace
+// Releases are stored in secrets in the namespace as they are by the helm command, so releases installed by
+// other processes, e.g. a previous test run, can be listed and uninstalled. The secrets are labeled with the
+// labels of the job's pod.
 func getConfig(namespace string) (*action.Configuration, error) {
+	labels := config.GetLabelsFromEnv()
 	config := &action.Configuration{}
-	if err := config.Init(settings.RESTClientGetter(), namespace, "memory", log.Printf); err != nil {
+	if err := config.Init(settings.RESTClientGetter(), namespace, "secrets", log.Printf); err != nil {
 		return nil, err
 	}
+	config.KubeClient = &applyClient{Interface: config.KubeClient}
+	client, err := config.KubernetesClientSet()
+	if err != nil {
+		return nil, err
+	}
+	config.Releases = storage.Init(&labelingDriver{
+		Driver:  config.Releases.Driver,
+		secrets: client.CoreV1().Secrets(namespace),
+		labels:  labels,
+	})
 	return config, nil
 }
 
@@ -70,6 +97,50 @@ type HelmClient interface {
 
 	// Namespace returns the client for the given namespace
 	Namespace(namespace string) HelmClient
+
+	// UninstallMatching uninstalls all releases in the namespace that match the given selector
+	UninstallMatching(ctx gocontext.Context, selector ReleaseSelector) error
+
+	// ListReleases lists the releases installed in the namespace
+	ListReleases(ctx gocontext.Context) ([]*ReleaseInfo, error)
+
+	// AddRepository adds a chart repository
+	AddRepository(name, url string, opts RepoOptions) error
+}
+
+// activeStates is the mask of states in which a release is installed or being installed
+const activeStates = action.ListDeployed | action.ListFailed | action.ListPendingInstall | action.ListPendingUpgrade | action.ListPendingRollback
+
+// ReleaseInfo describes an installed release
+type ReleaseInfo struct {
+	ReleaseStatus
+	// Name is the name of the release
+	Name string
+	// Namespace is the namespace in which the release is installed
+	Namespace string
+	// Chart is the name of the release's chart
+	Chart string
+	// ChartVersion is the version of the release's chart
+	ChartVersion string
+}
+
+// ReleaseSelector selects releases by name and labels
+type ReleaseSelector struct {
+	// Name is a regular expression matching release names
+	Name string
+	// Labels is a label selector matching the labels of the pods that installed the releases,
+	// e.g. revision=<commit>
+	Labels string
+}
+
+// UninstallMatching uninstalls all releases in the namespace that match the given selector
+func UninstallMatching(ctx gocontext.Context, selector ReleaseSelector) error {
+	return Client().UninstallMatching(ctx, selector)
+}
+
+// ListReleases lists the releases installed in the namespace
+func ListReleases(ctx gocontext.Context) ([]*ReleaseInfo, error) {
+	return Client().ListReleases(ctx)
 }
 
 // helmClient is an implementation of the HelmClient interface
@@ -84,6 +155,88 @@ func (c *helmClient) Namespace(namespace string) HelmClient {
 	return getClient(namespace)
 }
 
+// UninstallMatching uninstalls all releases in the namespace matching the given selector
+// Releases are listed from the Helm storage, including releases installed by other processes, e.g. a previous
+// test run, and each matching release is uninstalled. If any release cannot be uninstalled, the remaining
+// releases are still uninstalled and the errors are aggregated.
+func (c *helmClient) UninstallMatching(ctx gocontext.Context, selector ReleaseSelector) error {
+	list := action.NewList(c.config)
+	list.All = true
+	list.StateMask = activeStates
+	list.Filter = selector.Name
+	list.Selector = selector.Labels
+	var releases []*release.Release
+	err := runContext(ctx, func() error {
+		var err error
+		releases, err = list.Run()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, release := range releases {
+		uninstall := action.NewUninstall(c.config)
+		err := runContext(ctx, func() error {
+			_, err := uninstall.Run(release.Name)
+			return err
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		} else if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", release.Name, err))
+			continue
+		}
+		if r := c.Release(release.Name); r != nil {
+			r.release = nil
+			r.refs = 0
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to uninstall releases: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// ListReleases lists the releases installed in the namespace, sorted by name
+// Releases are listed from the Helm storage, including releases installed by other processes.
+func (c *helmClient) ListReleases(ctx gocontext.Context) ([]*ReleaseInfo, error) {
+	list := action.NewList(c.config)
+	list.All = true
+	list.StateMask = activeStates
+	var releases []*release.Release
+	err := runContext(ctx, func() error {
+		var err error
+		releases, err = list.Run()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*ReleaseInfo, 0, len(releases))
+	for _, release := range releases {
+		info := &ReleaseInfo{
+			Name:      release.Name,
+			Namespace: release.Namespace,
+			ReleaseStatus: ReleaseStatus{
+				Revision: release.Version,
+			},
+		}
+		if release.Chart != nil && release.Chart.Metadata != nil {
+			info.Chart = release.Chart.Metadata.Name
+			info.ChartVersion = release.Chart.Metadata.Version
+		}
+		if release.Info != nil {
+			info.Status = release.Info.Status.String()
+			info.LastDeployed = release.Info.LastDeployed.Time
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
 // Charts returns a list of charts in the cluster
 func (c *helmClient) Charts() []*HelmChart {
 	charts := make([]*HelmChart, 0, len(c.charts))
@@ -123,3 +276,25 @@ func (c *helmClient) Release(name string) *HelmRelease {
 	}
 	return nil
 }
+
+// TearDownSharedReleases uninstalls all shared releases installed in any namespace
+func TearDownSharedReleases() error {
+	for _, client := range clients {
+		for _, chart := range client.Charts() {
+			if !chart.Shared() {
+				continue
+			}
+			for _, release := range chart.Releases() {
+				if release.release == nil {
+					continue
+				}
+				if err := release.uninstall(gocontext.Background()); err != nil {
+					return err
+				}
+				release.release = nil
+				release.refs = 0
+			}
+		}
+	}
+	return nil
+}
@@ -43,9 +43,12 @@ func SetContext(ctx *Context) error {
 	}
 
 	context = &Context{
-		WorkDir:    ctxWorkDir,
-		Values:     ctx.Values,
-		ValueFiles: ctxValueFiles,
+		WorkDir:        ctxWorkDir,
+		Values:         ctx.Values,
+		StringValues:   ctx.StringValues,
+		ValueFiles:     ctxValueFiles,
+		FieldManager:   ctx.FieldManager,
+		ForceConflicts: ctx.ForceConflicts,
 	}
 	return nil
 }
@@ -58,15 +61,27 @@ type Context struct {
 	// Values is a mapping of release values
 	Values map[string][]string
 
+	// StringValues is a mapping of release values that are always set as strings, as with --set-string
+	StringValues map[string][]string
+
 	// ValueFiles is a mapping of release value files
 	ValueFiles map[string][]string
+
+	// FieldManager is the field manager with which release resources are created and updated using server-side apply
+	// If empty, resources are created and updated by Helm's client
+	FieldManager string
+
+	// ForceConflicts takes ownership of fields owned by other field managers when resources are applied
+	// If false, conflicts are returned as errors
+	ForceConflicts bool
 }
 
 // Release returns the context for the given release
 func (c *Context) Release(name string) *ReleaseContext {
 	return &ReleaseContext{
-		Values:     c.Values[name],
-		ValueFiles: c.ValueFiles[name],
+		Values:       c.Values[name],
+		StringValues: c.StringValues[name],
+		ValueFiles:   c.ValueFiles[name],
 	}
 }
 
@@ -77,4 +92,7 @@ type ReleaseContext struct {
 
 	// Values is the release values
 	Values []string
+
+	// StringValues is the release values that are always set as strings
+	StringValues []string
 }
@@ -0,0 +1,117 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint
+package helm
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ValuesDiff is a structured diff of release values keyed by dot-separated value paths
+type ValuesDiff struct {
+	// Added is the set of values added by the proposed values
+	Added map[string]interface{}
+	// Removed is the set of values removed by the proposed values
+	Removed map[string]interface{}
+	// Changed is the set of values changed by the proposed values
+	Changed map[string]ValueChange
+}
+
+// ValueChange is a change to a single value
+type ValueChange struct {
+	Old interface{}
+	New interface{}
+}
+
+// Empty returns whether the diff contains no changes
+func (d *ValuesDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffValues returns the diff between the release's current values and the given proposed values
+// The proposed values are merged over the current values as they would be by an upgrade, with nil values
+// removing the value at that path. The current values include values read from files with SetFile and
+// values set via command line flags, as they are for an install or upgrade. The release is not modified.
+func (r *HelmRelease) DiffValues(values map[string]interface{}) (*ValuesDiff, error) {
+	current, err := r.installValues()
+	if err != nil {
+		return nil, err
+	}
+	proposed := mergeMaps(current, normalize(values).(map[string]interface{}))
+	diff := &ValuesDiff{
+		Added:   make(map[string]interface{}),
+		Removed: make(map[string]interface{}),
+		Changed: make(map[string]ValueChange),
+	}
+	diffValues(nil, current, proposed, diff)
+	return diff, nil
+}
+
+// diffValues recursively compares the old and new values under the given path
+func diffValues(path []string, oldValues, newValues map[string]interface{}, diff *ValuesDiff) {
+	for key, oldValue := range oldValues {
+		keyPath := append(append([]string{}, path...), key)
+		newValue, ok := newValues[key]
+		if !ok || newValue == nil {
+			if oldValue != nil {
+				diff.Removed[strings.Join(keyPath, ".")] = oldValue
+			}
+			continue
+		}
+
+		oldMap, oldIsMap := oldValue.(map[string]interface{})
+		newMap, newIsMap := newValue.(map[string]interface{})
+		if oldIsMap && newIsMap {
+			diffValues(keyPath, oldMap, newMap, diff)
+		} else if !valuesEqual(oldValue, newValue) {
+			diff.Changed[strings.Join(keyPath, ".")] = ValueChange{
+				Old: oldValue,
+				New: newValue,
+			}
+		}
+	}
+
+	for key, newValue := range newValues {
+		if _, ok := oldValues[key]; ok || newValue == nil {
+			continue
+		}
+		diff.Added[strings.Join(append(append([]string{}, path...), key), ".")] = newValue
+	}
+}
+
+// valuesEqual compares two values, treating numbers of different types as equal if their values are equal
+func valuesEqual(a, b interface{}) bool {
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok {
+			return af == bf
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toFloat converts a numeric value to a float64
+func toFloat(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,56 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import "fmt"
+
+// ReleaseNotFound is returned when a release is not installed
+type ReleaseNotFound struct {
+	// Release is the name of the release
+	Release string
+}
+
+func (e *ReleaseNotFound) Error() string {
+	return fmt.Sprintf("release %s not found", e.Release)
+}
+
+// IsReleaseNotFound returns whether the given error is a ReleaseNotFound error
+func IsReleaseNotFound(err error) bool {
+	_, ok := err.(*ReleaseNotFound)
+	return ok
+}
+
+// ReleaseConflict is returned when a release is installed with the name of an installed release of another chart
+type ReleaseConflict struct {
+	// Release is the name of the release
+	Release string
+	// Namespace is the namespace in which the release is installed
+	Namespace string
+	// Chart is the name of the chart being installed
+	Chart string
+	// InstalledChart is the name of the chart of the installed release
+	InstalledChart string
+}
+
+func (e *ReleaseConflict) Error() string {
+	return fmt.Sprintf("cannot install release %s of chart %s: release %s of chart %s is already installed in namespace %s",
+		e.Release, e.Chart, e.Release, e.InstalledChart, e.Namespace)
+}
+
+// IsReleaseConflict returns whether the given error is a ReleaseConflict error
+func IsReleaseConflict(err error) bool {
+	_, ok := err.(*ReleaseConflict)
+	return ok
+}
@@ -0,0 +1,111 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// InstallGraph installs the releases of the given charts and the charts they depend on
+// Charts are installed in dependency order: independent charts are installed in parallel, and a chart's releases
+// are installed only once the releases of all the charts it depends on are ready. Each release waits for its own
+// Timeout, so slow dependencies can be given longer timeouts than the charts that depend on them. If the
+// dependencies form a cycle, an error describing the cycle is returned and no charts are installed.
+func InstallGraph(charts ...*HelmChart) error {
+	graph := make([]*HelmChart, 0, len(charts))
+	visited := make(map[*HelmChart]bool)
+	for _, chart := range charts {
+		if err := sortGraph(chart, visited, nil, &graph); err != nil {
+			return err
+		}
+	}
+
+	done := make(map[*HelmChart]chan struct{})
+	errs := make(map[*HelmChart]error)
+	for _, chart := range graph {
+		done[chart] = make(chan struct{})
+	}
+
+	mu := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, chart := range graph {
+		wg.Add(1)
+		go func(chart *HelmChart) {
+			defer wg.Done()
+			defer close(done[chart])
+			err := installNode(chart, done, errs, mu)
+			mu.Lock()
+			errs[chart] = err
+			mu.Unlock()
+		}(chart)
+	}
+	wg.Wait()
+
+	for _, chart := range graph {
+		if err := errs[chart]; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// installNode installs the releases of the given chart once its dependencies have been installed
+func installNode(chart *HelmChart, done map[*HelmChart]chan struct{}, errs map[*HelmChart]error, mu *sync.Mutex) error {
+	for _, dependency := range chart.dependencies {
+		<-done[dependency]
+		mu.Lock()
+		err := errs[dependency]
+		mu.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to install chart %s: dependency %s failed: %v", chart.Name(), dependency.Name(), err)
+		}
+	}
+	for _, release := range chart.Releases() {
+		if err := release.Install(true); err != nil {
+			return fmt.Errorf("failed to install release %s: %v", release.Name(), err)
+		}
+	}
+	return nil
+}
+
+// sortGraph appends the given chart to the graph after the charts it depends on
+// The path is the list of charts being visited and is used to report dependency cycles.
+func sortGraph(chart *HelmChart, visited map[*HelmChart]bool, path []*HelmChart, graph *[]*HelmChart) error {
+	for i, node := range path {
+		if node == chart {
+			names := make([]string, 0, len(path)-i+1)
+			for _, node := range path[i:] {
+				names = append(names, node.Name())
+			}
+			names = append(names, chart.Name())
+			return fmt.Errorf("dependency cycle detected: %s", strings.Join(names, " -> "))
+		}
+	}
+	if visited[chart] {
+		return nil
+	}
+
+	path = append(path, chart)
+	for _, dependency := range chart.dependencies {
+		if err := sortGraph(dependency, visited, path, graph); err != nil {
+			return err
+		}
+	}
+	visited[chart] = true
+	*graph = append(*graph, chart)
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSortGraph(t *testing.T) {
+	tests := []struct {
+		name     string
+		charts   func() *HelmChart
+		expected []string
+		err      string
+	}{
+		{
+			name: "no dependencies",
+			charts: func() *HelmChart {
+				return &HelmChart{name: "a"}
+			},
+			expected: []string{"a"},
+		},
+		{
+			name: "chain",
+			charts: func() *HelmChart {
+				c := &HelmChart{name: "c"}
+				b := (&HelmChart{name: "b"}).DependsOn(c)
+				return (&HelmChart{name: "a"}).DependsOn(b)
+			},
+			expected: []string{"c", "b", "a"},
+		},
+		{
+			name: "diamond",
+			charts: func() *HelmChart {
+				d := &HelmChart{name: "d"}
+				b := (&HelmChart{name: "b"}).DependsOn(d)
+				c := (&HelmChart{name: "c"}).DependsOn(d)
+				return (&HelmChart{name: "a"}).DependsOn(b, c)
+			},
+			expected: []string{"d", "b", "c", "a"},
+		},
+		{
+			name: "self dependency",
+			charts: func() *HelmChart {
+				a := &HelmChart{name: "a"}
+				return a.DependsOn(a)
+			},
+			err: "dependency cycle detected: a -> a",
+		},
+		{
+			name: "cycle",
+			charts: func() *HelmChart {
+				a := &HelmChart{name: "a"}
+				b := (&HelmChart{name: "b"}).DependsOn(a)
+				return a.DependsOn(b)
+			},
+			err: "dependency cycle detected: a -> b -> a",
+		},
+		{
+			name: "cycle below root",
+			charts: func() *HelmChart {
+				b := &HelmChart{name: "b"}
+				c := (&HelmChart{name: "c"}).DependsOn(b)
+				b.DependsOn(c)
+				return (&HelmChart{name: "a"}).DependsOn(b)
+			},
+			err: "dependency cycle detected: b -> c -> b",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var graph []*HelmChart
+			err := sortGraph(test.charts(), make(map[*HelmChart]bool), nil, &graph)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			names := make([]string, 0, len(graph))
+			for _, chart := range graph {
+				names = append(names, chart.Name())
+			}
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"bytes"
+	gocontext "context"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/deislabs/oras/pkg/content"
+	"github.com/deislabs/oras/pkg/oras"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+)
+
+const (
+	// ociScheme is the URL scheme of OCI registry chart repositories
+	ociScheme = "oci://"
+	// ociConfigMediaType is the media type of a chart's config in an OCI registry
+	ociConfigMediaType = "application/vnd.cncf.helm.config.v1+json"
+	// ociContentMediaType is the media type of a chart's archive in an OCI registry
+	ociContentMediaType = "application/tar+gzip"
+)
+
+// isOCIRepository returns whether the given repository URL refers to an OCI registry
+func isOCIRepository(repository string) bool {
+	return strings.HasPrefix(repository, ociScheme)
+}
+
+// getOCIReference returns the OCI reference of the given version of a chart in an OCI registry repository
+func getOCIReference(repository, name, version string) (string, error) {
+	if version == "" {
+		return "", fmt.Errorf("chart %s in OCI registry %s requires a version", name, repository)
+	}
+	return fmt.Sprintf("%s/%s:%s", strings.TrimSuffix(strings.TrimPrefix(repository, ociScheme), "/"), name, version), nil
+}
+
+// pullOCIChart pulls and loads the chart with the given reference from an OCI registry
+// If a username or password is provided, they're used to authenticate with the registry.
+func pullOCIChart(ref, username, password string) (*chart.Chart, error) {
+	authorizer := docker.NewDockerAuthorizer(docker.WithAuthCreds(func(string) (string, string, error) {
+		return username, password, nil
+	}))
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Hosts: docker.ConfigureDefaultRegistries(docker.WithAuthorizer(authorizer)),
+	})
+
+	store := content.NewMemoryStore()
+	_, layers, err := oras.Pull(gocontext.Background(), resolver, ref, store,
+		oras.WithPullEmptyNameAllowed(),
+		oras.WithAllowedMediaTypes([]string{ociConfigMediaType, ociContentMediaType}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull chart %s: %v", ref, err)
+	}
+
+	for _, layer := range layers {
+		if layer.MediaType != ociContentMediaType {
+			continue
+		}
+		_, data, ok := store.Get(layer)
+		if !ok {
+			return nil, fmt.Errorf("failed to pull chart %s: missing layer %s", ref, layer.Digest)
+		}
+		return loader.LoadArchive(bytes.NewReader(data))
+	}
+	return nil, fmt.Errorf("failed to pull chart %s: no layer with media type %s", ref, ociContentMediaType)
+}
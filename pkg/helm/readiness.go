@@ -0,0 +1,168 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	gocontext "context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Readiness defines when the workloads of a release are considered ready
+// Readiness is checked in addition to Helm's own wait, which considers a Deployment ready once its minimum
+// number of replicas is available. If neither MinReadyPercentage nor RequireAll is set, all replicas must be ready.
+type Readiness struct {
+	// MinReadyPercentage is the minimum percentage of each workload's desired replicas that must be ready
+	MinReadyPercentage int
+	// RequireAll requires all of each workload's desired replicas to be ready
+	RequireAll bool
+	// Workloads is the names of the Deployments, StatefulSets, and DaemonSets to check
+	// If empty, all the workloads in the release are checked.
+	Workloads []string
+}
+
+// minReadyPercentage returns the percentage of desired replicas that must be ready
+func (r *Readiness) minReadyPercentage() int {
+	if r.RequireAll || r.MinReadyPercentage <= 0 || r.MinReadyPercentage > 100 {
+		return 100
+	}
+	return r.MinReadyPercentage
+}
+
+// workload is the readiness state of a single workload in a release
+type workload struct {
+	kind    string
+	name    string
+	desired int
+	ready   int
+}
+
+func (w workload) String() string {
+	return fmt.Sprintf("%s %s (%d/%d ready)", w.kind, w.name, w.ready, w.desired)
+}
+
+// awaitReady waits for the release's workloads to satisfy the chart's readiness criteria
+// The context's error is returned if the context is done before the workloads are ready.
+func (r *HelmRelease) awaitReady(ctx gocontext.Context) error {
+	readiness := r.chart.Readiness()
+	if readiness == nil {
+		return nil
+	}
+
+	resources, err := r.GetResources()
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool)
+	for _, name := range readiness.Workloads {
+		names[name] = true
+	}
+	found := make(map[string]bool)
+	var kinds = map[string]bool{"Deployment": true, "StatefulSet": true, "DaemonSet": true}
+	var workloads []workload
+	for _, resource := range resources {
+		if resource.Mapping == nil || !kinds[resource.Mapping.GroupVersionKind.Kind] {
+			continue
+		}
+		if len(names) > 0 && !names[resource.Name] {
+			continue
+		}
+		found[resource.Name] = true
+		workloads = append(workloads, workload{
+			kind: resource.Mapping.GroupVersionKind.Kind,
+			name: resource.Name,
+		})
+	}
+
+	var missing []string
+	for name := range names {
+		if !found[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("release %s has no workloads named %s", r.Name(), strings.Join(missing, ", "))
+	}
+
+	percentage := readiness.minReadyPercentage()
+	var notReady []workload
+	timeoutCtx, cancel := gocontext.WithTimeout(ctx, r.Timeout())
+	defer cancel()
+	err = wait.PollUntil(time.Second, func() (bool, error) {
+		var waiting []workload
+		for _, w := range workloads {
+			if err := r.getWorkloadState(timeoutCtx, &w); err != nil {
+				return false, err
+			}
+			if w.ready*100 < w.desired*percentage {
+				waiting = append(waiting, w)
+			}
+		}
+		notReady = waiting
+		return len(notReady) == 0, nil
+	}, timeoutCtx.Done())
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err == wait.ErrWaitTimeout || timeoutCtx.Err() != nil {
+		states := make([]string, len(notReady))
+		for i, w := range notReady {
+			states[i] = w.String()
+		}
+		return fmt.Errorf("release %s is not ready: %s", r.Name(), strings.Join(states, ", "))
+	}
+	return err
+}
+
+// getWorkloadState updates the desired and ready replicas of the given workload
+func (r *HelmRelease) getWorkloadState(ctx gocontext.Context, w *workload) error {
+	switch w.kind {
+	case "Deployment":
+		deployment, err := r.client.AppsV1().Deployments(r.Namespace()).Get(ctx, w.name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		w.desired = 1
+		if deployment.Spec.Replicas != nil {
+			w.desired = int(*deployment.Spec.Replicas)
+		}
+		w.ready = int(deployment.Status.ReadyReplicas)
+	case "StatefulSet":
+		set, err := r.client.AppsV1().StatefulSets(r.Namespace()).Get(ctx, w.name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		w.desired = 1
+		if set.Spec.Replicas != nil {
+			w.desired = int(*set.Spec.Replicas)
+		}
+		w.ready = int(set.Status.ReadyReplicas)
+	case "DaemonSet":
+		set, err := r.client.AppsV1().DaemonSets(r.Namespace()).Get(ctx, w.name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		w.desired = int(set.Status.DesiredNumberScheduled)
+		w.ready = int(set.Status.NumberReady)
+	}
+	return nil
+}
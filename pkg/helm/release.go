@@ -17,10 +17,15 @@ package helm
 
 import (
 	"bytes"
+	gocontext "context"
 	"encoding/csv"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -34,11 +39,16 @@ import (
 	"helm.sh/helm/v3/pkg/getter"
 	helm "helm.sh/helm/v3/pkg/kube"
 	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage/driver"
 	"k8s.io/client-go/kubernetes"
 )
 
 var settings = cli.New()
 
+// defaultTimeout is the timeout for Helm operations when neither the release nor its chart sets one
+const defaultTimeout = 5 * time.Minute
+
 // HelmReleaseClient is a Helm release client
 type HelmReleaseClient interface {
 	// Releases returns a list of releases in the namespace
@@ -61,8 +71,9 @@ func Releases() []*HelmRelease {
 func newRelease(name string, namespace string, client *kubernetes.Clientset, chart *HelmChart, config *action.Configuration) *HelmRelease {
 	ctx := context.Release(name)
 	opts := &values.Options{
-		ValueFiles: ctx.ValueFiles,
-		Values:     ctx.Values,
+		ValueFiles:   ctx.ValueFiles,
+		Values:       ctx.Values,
+		StringValues: ctx.StringValues,
 	}
 	values, err := opts.MergeValues(getter.All(settings))
 	if err != nil {
@@ -77,8 +88,8 @@ func newRelease(name string, namespace string, client *kubernetes.Clientset, cha
 		context:   ctx,
 		name:      name,
 		values:    make(map[string]interface{}),
+		files:     make(map[string]string),
 		overrides: values,
-		timeout:   5 * time.Minute,
 	}
 }
 
@@ -91,12 +102,15 @@ type HelmRelease struct {
 	context   *ReleaseContext
 	name      string
 	values    map[string]interface{}
+	files     map[string]string
 	overrides map[string]interface{}
 	skipCRDs  bool
+	atomic    bool
 	release   *release.Release
 	userName  string
 	password  string
 	timeout   time.Duration
+	refs      int
 }
 
 // Namespace returns the release namespace
@@ -115,6 +129,30 @@ func (r *HelmRelease) Set(path string, value interface{}) *HelmRelease {
 	return r
 }
 
+// SetAll deep-merges the given values into the release's values
+// Nested maps are merged with the values already set, and values in the given map override existing values,
+// as when Helm merges values files.
+func (r *HelmRelease) SetAll(values map[string]interface{}) *HelmRelease {
+	r.values = mergeMaps(r.values, normalizeMap(values).(map[string]interface{}))
+	return r
+}
+
+// SetString sets a string value
+// This is the programmatic equivalent of the --set-string flag: unlike values parsed from the --set flag, the
+// value is never coerced to a number or boolean, so version tags like "1.0" and boolean-like strings like "true"
+// are rendered as strings.
+func (r *HelmRelease) SetString(path string, value string) *HelmRelease {
+	return r.Set(path, value)
+}
+
+// SetFile sets a value to the contents of a file
+// The file is read when the release is installed, upgraded, or rendered. Relative paths are resolved against
+// the Helm context's working directory.
+func (r *HelmRelease) SetFile(path string, file string) *HelmRelease {
+	r.files[path] = file
+	return r
+}
+
 // Get gets a value
 func (r *HelmRelease) Get(path string) interface{} {
 	return getValue(r.Values(), getPathNames(path))
@@ -139,8 +177,15 @@ func (r *HelmRelease) WithTimeout(timeout time.Duration) *HelmRelease {
 }
 
 // Timeout returns the maximum time to allow for an install operation to complete
+// If no timeout is set on the release, the chart's timeout is used, falling back to Helm's default.
 func (r *HelmRelease) Timeout() time.Duration {
-	return r.timeout
+	if r.timeout > 0 {
+		return r.timeout
+	}
+	if r.chart.Timeout() > 0 {
+		return r.chart.Timeout()
+	}
+	return defaultTimeout
 }
 
 // Values is the release's values
@@ -151,6 +196,36 @@ func (r *HelmRelease) Values() map[string]interface{} {
 	return mergeMaps(r.release.Chart.Values, r.release.Config)
 }
 
+// GetValues returns the values of the installed release as computed by Helm
+// If all is true, the chart's default values are included. A ReleaseNotFound error is returned if the release
+// is not installed.
+func (r *HelmRelease) GetValues(all bool) (map[string]interface{}, error) {
+	get := action.NewGetValues(r.config)
+	get.AllValues = all
+	values, err := get.Run(r.Name())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, &ReleaseNotFound{Release: r.Name()}
+		}
+		return nil, err
+	}
+	return values, nil
+}
+
+// Manifest returns the manifest Helm rendered and applied for the current revision of the installed release
+// Unlike Template, the manifest is read from the cluster, as with `helm get manifest`. A ReleaseNotFound error is
+// returned if the release is not installed.
+func (r *HelmRelease) Manifest() (string, error) {
+	release, err := action.NewGet(r.config).Run(r.Name())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return "", &ReleaseNotFound{Release: r.Name()}
+		}
+		return "", err
+	}
+	return release.Manifest, nil
+}
+
 // SetSkipCRDs sets whether to skip CRDs
 func (r *HelmRelease) SetSkipCRDs(skipCRDs bool) *HelmRelease {
 	r.skipCRDs = skipCRDs
@@ -158,8 +233,22 @@ func (r *HelmRelease) SetSkipCRDs(skipCRDs bool) *HelmRelease {
 }
 
 // SkipCRDs returns whether CRDs are skipped in the release
+// CRDs are skipped if they're skipped for either the release or its chart.
 func (r *HelmRelease) SkipCRDs() bool {
-	return r.skipCRDs
+	return r.skipCRDs || r.chart.SkipCRDs()
+}
+
+// SetAtomic sets whether installs and upgrades of the release are atomic
+// If an atomic install fails, the release is uninstalled, and if an atomic upgrade fails, the release is rolled
+// back to its prior revision. Atomic installs and upgrades always wait for the release's resources to become ready.
+func (r *HelmRelease) SetAtomic(atomic bool) *HelmRelease {
+	r.atomic = atomic
+	return r
+}
+
+// Atomic returns whether installs and upgrades of the release are atomic
+func (r *HelmRelease) Atomic() bool {
+	return r.atomic
 }
 
 // GetResources returns a list of chart resources
@@ -171,6 +260,109 @@ func (r *HelmRelease) GetResources() (helm.ResourceList, error) {
 	return resources, nil
 }
 
+// Notes returns the release notes rendered from the chart's NOTES.txt when the release was installed
+func (r *HelmRelease) Notes() (string, error) {
+	if r.release == nil {
+		return "", fmt.Errorf("release %s is not installed", r.Name())
+	}
+	return r.release.Info.Notes, nil
+}
+
+// ReleaseStatus is the status of an installed release
+type ReleaseStatus struct {
+	// Status is the state of the release, e.g. deployed, failed, or pending-install
+	Status string
+	// Revision is the current revision of the release
+	Revision int
+	// LastDeployed is the time at which the current revision was deployed
+	LastDeployed time.Time
+}
+
+// Status returns the status of the release
+// A ReleaseNotFound error is returned if the release is not installed.
+func (r *HelmRelease) Status() (ReleaseStatus, error) {
+	release, err := action.NewStatus(r.config).Run(r.Name())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return ReleaseStatus{}, &ReleaseNotFound{Release: r.Name()}
+		}
+		return ReleaseStatus{}, err
+	}
+
+	status := ReleaseStatus{
+		Revision: release.Version,
+	}
+	if release.Info != nil {
+		status.Status = release.Info.Status.String()
+		status.LastDeployed = release.Info.LastDeployed.Time
+	}
+	return status, nil
+}
+
+// ReleaseRevision is a revision of a release
+type ReleaseRevision struct {
+	// Revision is the number of the revision
+	Revision int
+	// Status is the state of the revision, e.g. deployed, superseded, or failed
+	Status string
+	// ChartVersion is the version of the chart installed by the revision
+	ChartVersion string
+	// Updated is the time at which the revision was deployed
+	Updated time.Time
+	// Description is a human-readable description of the revision, e.g. "Rollback to 2"
+	Description string
+}
+
+// History returns the revisions of the release, oldest first
+// A ReleaseNotFound error is returned if the release is not installed.
+func (r *HelmRelease) History() ([]ReleaseRevision, error) {
+	releases, err := action.NewHistory(r.config).Run(r.Name())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, &ReleaseNotFound{Release: r.Name()}
+		}
+		return nil, err
+	}
+
+	revisions := make([]ReleaseRevision, 0, len(releases))
+	for _, release := range releases {
+		revision := ReleaseRevision{
+			Revision: release.Version,
+		}
+		if release.Info != nil {
+			revision.Status = release.Info.Status.String()
+			revision.Updated = release.Info.LastDeployed.Time
+			revision.Description = release.Info.Description
+		}
+		if release.Chart != nil && release.Chart.Metadata != nil {
+			revision.ChartVersion = release.Chart.Metadata.Version
+		}
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return revisions, nil
+}
+
+// installValues returns the values with which to install the release
+// Values read from files take precedence over values set programmatically, and values set via command
+// line flags take precedence over both.
+func (r *HelmRelease) installValues() (map[string]interface{}, error) {
+	files := make(map[string]interface{})
+	for path, file := range r.files {
+		if !filepath.IsAbs(file) && context.WorkDir != "" {
+			file = filepath.Join(context.WorkDir, file)
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file for value %s: %v", path, err)
+		}
+		setKey(files, getPathNames(path), string(data))
+	}
+	return mergeMaps(mergeMaps(normalize(r.values).(map[string]interface{}), files), r.overrides), nil
+}
+
 // setContextDir sets the directory to the context dir
 func (r *HelmRelease) setContextDir() error {
 	if context.WorkDir != "" {
@@ -182,36 +374,325 @@ func (r *HelmRelease) setContextDir() error {
 }
 
 // Install installs the Helm chart
+// If the chart is shared and the release is already installed, the release's reference count is incremented.
 func (r *HelmRelease) Install(wait bool) error {
+	return r.InstallContext(gocontext.Background(), wait)
+}
+
+// InstallContext installs the Helm chart, returning the context's error if the context is done first
+// The install timeout is shortened to the context's deadline, if any. Helm operations cannot be interrupted,
+// so an install that's abandoned when the context is cancelled continues in the background until it completes
+// or its timeout expires.
+func (r *HelmRelease) InstallContext(ctx gocontext.Context, wait bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.chart.Shared() && r.refs > 0 {
+		r.refs++
+		return nil
+	}
+
+	if err := r.checkConflict(); err != nil {
+		return err
+	}
+
+	if err := r.setContextDir(); err != nil {
+		return err
+	}
+
+	install := r.newInstall()
+	install.Wait = wait || r.Atomic()
+	install.Atomic = r.Atomic()
+	install.Timeout = contextTimeout(ctx, install.Timeout)
+	chart, err := r.loadChart(install)
+	if err != nil {
+		return err
+	}
+
+	values, err := r.installValues()
+	if err != nil {
+		return err
+	}
+	var release *release.Release
+	err = runContext(ctx, func() error {
+		var err error
+		release, err = install.Run(chart, values)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	r.release = release
+	if install.Wait {
+		if err := r.awaitReady(ctx); err != nil {
+			if r.Atomic() {
+				if uninstallErr := r.uninstall(gocontext.Background()); uninstallErr != nil {
+					return fmt.Errorf("%v: failed to uninstall release: %v", err, uninstallErr)
+				}
+				r.release = nil
+			}
+			return err
+		}
+	}
+	if r.chart.Shared() {
+		r.refs++
+	}
+	return nil
+}
+
+// checkConflict returns a ReleaseConflict error if a release of another chart with the same name is installed in
+// the release's namespace
+// Installing the release would otherwise replace the other chart's release, leaving it in a confusing state.
+func (r *HelmRelease) checkConflict() error {
+	for _, release := range getClient(r.namespace).Releases() {
+		if release != r && release.Name() == r.Name() && release.release != nil {
+			return &ReleaseConflict{
+				Release:        r.Name(),
+				Namespace:      r.Namespace(),
+				Chart:          r.chart.Name(),
+				InstalledChart: release.chart.Name(),
+			}
+		}
+	}
+	return nil
+}
+
+// Upgrade upgrades the installed release with the values set since it was installed
+// The values of the installed release are reused unless overridden with Set. If wait is true, Upgrade waits
+// for the upgraded resources to become ready, as Install does. An error is returned if the release is not installed.
+func (r *HelmRelease) Upgrade(wait bool) error {
+	return r.UpgradeContext(gocontext.Background(), wait)
+}
+
+// UpgradeContext upgrades the installed release, returning the context's error if the context is done first
+// As with InstallContext, the upgrade timeout is shortened to the context's deadline, and an upgrade that's
+// abandoned when the context is cancelled continues in the background.
+func (r *HelmRelease) UpgradeContext(ctx gocontext.Context, wait bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if err := r.setContextDir(); err != nil {
 		return err
 	}
 
+	current := r.release
+	if current == nil {
+		var err error
+		current, err = action.NewGet(r.config).Run(r.Name())
+		if err != nil {
+			if errors.Is(err, driver.ErrReleaseNotFound) {
+				return fmt.Errorf("cannot upgrade release %s: release is not installed", r.Name())
+			}
+			return err
+		}
+	}
+
+	upgrade := action.NewUpgrade(r.config)
+	upgrade.Namespace = r.Namespace()
+	r.setChartPathOptions(&upgrade.ChartPathOptions)
+	upgrade.SkipCRDs = r.SkipCRDs()
+	upgrade.Timeout = contextTimeout(ctx, r.Timeout())
+	upgrade.Wait = wait || r.Atomic()
+	upgrade.Atomic = r.Atomic()
+
+	// The chart is located with the same options used to install the release
+	chart, err := r.loadChart(r.newInstall())
+	if err != nil {
+		return err
+	}
+
+	values, err := r.installValues()
+	if err != nil {
+		return err
+	}
+	var release *release.Release
+	err = runContext(ctx, func() error {
+		var err error
+		release, err = upgrade.Run(r.Name(), chart, mergeMaps(current.Config, values))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	r.release = release
+	if upgrade.Wait {
+		if err := r.awaitReady(ctx); err != nil {
+			if r.Atomic() {
+				if rollbackErr := r.Rollback(current.Version, true); rollbackErr != nil {
+					return fmt.Errorf("%v: failed to roll back release: %v", err, rollbackErr)
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback rolls the installed release back to the given revision
+// A revision of 0 rolls the release back to the previous revision. If wait is true, Rollback waits for the
+// rolled back resources to become ready. An error is returned if the release or revision does not exist.
+func (r *HelmRelease) Rollback(revision int, wait bool) error {
+	return r.RollbackContext(gocontext.Background(), revision, wait)
+}
+
+// RollbackContext rolls the installed release back to the given revision, returning the context's error if the
+// context is done first
+// As with InstallContext, the rollback timeout is shortened to the context's deadline, and a rollback that's
+// abandoned when the context is cancelled continues in the background.
+func (r *HelmRelease) RollbackContext(ctx gocontext.Context, revision int, wait bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	rollback := action.NewRollback(r.config)
+	rollback.Version = revision
+	rollback.Timeout = contextTimeout(ctx, r.Timeout())
+	rollback.Wait = wait
+	if err := runContext(ctx, func() error {
+		return rollback.Run(r.Name())
+	}); err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return fmt.Errorf("cannot roll back release %s: release is not installed", r.Name())
+		}
+		return err
+	}
+
+	release, err := action.NewGet(r.config).Run(r.Name())
+	if err != nil {
+		return err
+	}
+	r.release = release
+	return nil
+}
+
+// Template renders the release's chart with the release's values and returns the rendered manifests
+// The chart is rendered without connecting to the cluster, as with `helm template`. The rendered manifests
+// include the chart's hooks and, unless CRDs are skipped, the chart's CRDs.
+func (r *HelmRelease) Template() ([]byte, error) {
+	if err := r.setContextDir(); err != nil {
+		return nil, err
+	}
+
+	// Client-only installs replace the configuration's client and storage, so render with a separate configuration
+	config := &action.Configuration{
+		Log: func(string, ...interface{}) {},
+	}
+	install := action.NewInstall(config)
+	install.Namespace = r.Namespace()
+	r.setChartPathOptions(&install.ChartPathOptions)
+	install.ReleaseName = r.Name()
+	install.DryRun = true
+	install.ClientOnly = true
+	install.Replace = true
+	install.IncludeCRDs = !r.SkipCRDs()
+
+	chart, err := r.loadChart(install)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := r.installValues()
+	if err != nil {
+		return nil, err
+	}
+	release, err := install.Run(chart, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests bytes.Buffer
+	fmt.Fprintln(&manifests, strings.TrimSpace(release.Manifest))
+	for _, hook := range release.Hooks {
+		fmt.Fprintf(&manifests, "---\n# Source: %s\n%s\n", hook.Path, hook.Manifest)
+	}
+	return manifests.Bytes(), nil
+}
+
+// newInstall returns a new install action for the release
+func (r *HelmRelease) newInstall() *action.Install {
 	install := action.NewInstall(r.config)
 	install.Namespace = r.Namespace()
-	install.Username = r.userName
-	install.Password = r.password
+	r.setChartPathOptions(&install.ChartPathOptions)
 	install.SkipCRDs = r.SkipCRDs()
-	install.RepoURL = r.chart.Repository()
 	install.ReleaseName = r.Name()
-	install.Wait = wait
 	install.Timeout = r.Timeout()
+	install.DependencyUpdate = r.chart.DependencyUpdate()
+	return install
+}
+
+// setChartPathOptions sets the options with which the release's chart is located
+// Charts in repositories added with AddRepository are located with the repository's URL and credentials, unless
+// credentials are set on the release.
+func (r *HelmRelease) setChartPathOptions(options *action.ChartPathOptions) {
+	options.RepoURL = r.chart.Repository()
+	options.Version = r.chart.Version()
+	options.Username = r.userName
+	options.Password = r.password
+	if _, entry := r.chartRepository(); entry != nil {
+		options.RepoURL = entry.URL
+		options.CertFile = entry.CertFile
+		options.KeyFile = entry.KeyFile
+		options.CaFile = entry.CAFile
+		if options.Username == "" {
+			options.Username = entry.Username
+			options.Password = entry.Password
+		}
+	}
+}
+
+// chartRepository returns the name of the release's chart within its repository and the repository, if the
+// chart is in a repository added with AddRepository
+// Charts in added repositories can be referenced by the repository's name or URL, or by a "<repository>/<chart>"
+// name.
+func (r *HelmRelease) chartRepository() (string, *repo.Entry) {
+	name := r.chart.Name()
+	if entry := getRepository(r.chart.Repository()); entry != nil {
+		return name, entry
+	}
+	if i := strings.Index(name, "/"); i > 0 && r.chart.Repository() == "" {
+		if entry := getRepository(name[:i]); entry != nil && entry.Name == name[:i] {
+			return name[i+1:], entry
+		}
+	}
+	return name, nil
+}
+
+// loadChart locates and loads the release's chart, updating its dependencies if necessary
+// Charts in OCI registries are pulled with their dependencies, so they're loaded directly.
+func (r *HelmRelease) loadChart(install *action.Install) (*chart.Chart, error) {
+	if isOCIRepository(r.chart.Repository()) {
+		ref, err := getOCIReference(r.chart.Repository(), r.chart.Name(), r.chart.Version())
+		if err != nil {
+			return nil, err
+		}
+		chart, err := pullOCIChart(ref, r.userName, r.password)
+		if err != nil {
+			return nil, err
+		}
+		if valid, err := isChartInstallable(chart); !valid {
+			return nil, err
+		}
+		return chart, nil
+	}
 
 	// Locate the chart path
-	path, err := install.ChartPathOptions.LocateChart(r.chart.Name(), settings)
+	name, _ := r.chartRepository()
+	path, err := install.ChartPathOptions.LocateChart(name, settings)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check chart dependencies to make sure all are present in /charts
 	chart, err := loader.Load(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	valid, err := isChartInstallable(chart)
 	if !valid {
-		return err
+		return nil, err
 	}
 
 	if req := chart.Metadata.Dependencies; req != nil {
@@ -230,31 +711,85 @@ func (r *HelmRelease) Install(wait bool) error {
 					RepositoryCache:  settings.RepositoryCache,
 				}
 				if err := man.Update(); err != nil {
-					return err
+					return nil, err
+				}
+				// Reload the chart to include the updated dependencies
+				if chart, err = loader.Load(path); err != nil {
+					return nil, err
 				}
 			} else {
-				return err
+				return nil, err
 			}
 		}
 	}
-
-	release, err := install.Run(chart, r.Values())
-	if err != nil {
-		return err
-	}
-	r.release = release
-	return nil
+	return chart, nil
 }
 
 // Uninstall uninstalls the Helm chart
+// If the chart is shared, the release's reference count is decremented and the release remains installed
+// until it's torn down by TearDownSharedReleases.
 func (r *HelmRelease) Uninstall() error {
+	return r.UninstallContext(gocontext.Background())
+}
+
+// UninstallContext uninstalls the Helm chart, returning the context's error if the context is done first
+// As with InstallContext, the timeout for uninstall hooks is shortened to the context's deadline, and an
+// uninstall that's abandoned when the context is cancelled continues in the background.
+func (r *HelmRelease) UninstallContext(ctx gocontext.Context) error {
+	if r.chart.Shared() {
+		if r.refs > 0 {
+			r.refs--
+		}
+		return nil
+	}
+	return r.uninstall(ctx)
+}
+
+// uninstall uninstalls the release
+func (r *HelmRelease) uninstall(ctx gocontext.Context) error {
 	if err := r.setContextDir(); err != nil {
 		return err
 	}
 
 	uninstall := action.NewUninstall(r.config)
-	_, err := uninstall.Run(r.Name())
-	return err
+	uninstall.Timeout = contextTimeout(ctx, r.Timeout())
+	return runContext(ctx, func() error {
+		_, err := uninstall.Run(r.Name())
+		return err
+	})
+}
+
+// runContext runs the given Helm operation, returning early with the context's error if the context is done
+// The operation is not started if the context is already done.
+func runContext(ctx gocontext.Context, f func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- f()
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// contextTimeout returns the given timeout, shortened to the context's deadline if it expires sooner
+// If the deadline has already passed, the smallest positive timeout is returned; callers check the context's
+// error before starting an operation, and runContext returns it once the operation is started.
+func contextTimeout(ctx gocontext.Context, timeout time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			if remaining <= 0 {
+				return time.Nanosecond
+			}
+			return remaining
+		}
+	}
+	return timeout
 }
 
 func mergeMaps(a, b map[string]interface{}) map[string]interface{} {
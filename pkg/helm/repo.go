@@ -0,0 +1,108 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+// RepoOptions configures access to a chart repository
+type RepoOptions struct {
+	// Username is the user name with which to authenticate with the repository
+	Username string
+	// Password is the password with which to authenticate with the repository
+	Password string
+	// CAFile is the path to a CA bundle used to verify the repository's certificate
+	CAFile string
+	// CertFile is the path to a client certificate with which to authenticate with the repository
+	CertFile string
+	// KeyFile is the path to the client certificate's key
+	KeyFile string
+}
+
+var (
+	repositories   = make(map[string]*repo.Entry)
+	repositoriesMu sync.RWMutex
+)
+
+// AddRepository adds a chart repository, as with `helm repo add`
+func AddRepository(name, url string, opts RepoOptions) error {
+	return Client().AddRepository(name, url, opts)
+}
+
+// AddRepository adds a chart repository, as with `helm repo add`
+// The repository's index is fetched to verify the repository is accessible, and the repository is written to
+// Helm's repositories file. Charts in the repository can then be referenced as "<name>/<chart>", or with the
+// repository's name or URL as the chart's repository, and are fetched with the repository's credentials.
+func (c *helmClient) AddRepository(name, url string, opts RepoOptions) error {
+	entry := &repo.Entry{
+		Name:     name,
+		URL:      url,
+		Username: opts.Username,
+		Password: opts.Password,
+		CAFile:   opts.CAFile,
+		CertFile: opts.CertFile,
+		KeyFile:  opts.KeyFile,
+	}
+
+	chartRepo, err := repo.NewChartRepository(entry, getter.All(settings))
+	if err != nil {
+		return err
+	}
+	chartRepo.CachePath = settings.RepositoryCache
+	if _, err := chartRepo.DownloadIndexFile(); err != nil {
+		return fmt.Errorf("failed to fetch index of repository %s at %s: %v", name, url, err)
+	}
+
+	repositoriesMu.Lock()
+	defer repositoriesMu.Unlock()
+	file, err := repo.LoadFile(settings.RepositoryConfig)
+	if err != nil {
+		if _, statErr := os.Stat(settings.RepositoryConfig); !os.IsNotExist(statErr) {
+			return err
+		}
+		file = repo.NewFile()
+	}
+	file.Update(entry)
+	if err := os.MkdirAll(filepath.Dir(settings.RepositoryConfig), 0755); err != nil {
+		return err
+	}
+	if err := file.WriteFile(settings.RepositoryConfig, 0600); err != nil {
+		return err
+	}
+	repositories[name] = entry
+	return nil
+}
+
+// getRepository returns the added repository with the given name or URL, or nil if no such repository was added
+func getRepository(nameOrURL string) *repo.Entry {
+	if nameOrURL == "" {
+		return nil
+	}
+	repositoriesMu.RLock()
+	defer repositoriesMu.RUnlock()
+	for _, entry := range repositories {
+		if entry.Name == nameOrURL || entry.URL == nameOrURL {
+			return entry
+		}
+	}
+	return nil
+}
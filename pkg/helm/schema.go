@@ -0,0 +1,103 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+)
+
+// ValidateValues validates the value overrides for each release against the values schema of the release's chart
+// Overrides are matched to a chart in the context directory by release name: the overrides for release foo are
+// validated against the chart in the directory foo, or against the context directory itself if it is a chart
+// named foo. Releases without a matching chart and charts without a values.schema.json are skipped.
+func ValidateValues(dir string, releaseValues, releaseStringValues, releaseValueFiles map[string][]string) error {
+	releases := make(map[string]bool)
+	for release := range releaseValues {
+		releases[release] = true
+	}
+	for release := range releaseStringValues {
+		releases[release] = true
+	}
+	for release := range releaseValueFiles {
+		releases[release] = true
+	}
+
+	names := make([]string, 0, len(releases))
+	for release := range releases {
+		names = append(names, release)
+	}
+	sort.Strings(names)
+
+	for _, release := range names {
+		if err := validateReleaseValues(dir, release, releaseValues[release], releaseStringValues[release], releaseValueFiles[release]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateReleaseValues validates the given value overrides against the schema of the chart for the given release
+func validateReleaseValues(dir string, release string, releaseValues, releaseStringValues, releaseValueFiles []string) error {
+	chartDir, ok := findReleaseChart(dir, release)
+	if !ok {
+		return nil
+	}
+	chart, err := loader.Load(chartDir)
+	if err != nil {
+		return fmt.Errorf("failed to load chart for release %s: %v", release, err)
+	}
+	if chart.Schema == nil {
+		return nil
+	}
+
+	opts := &values.Options{
+		Values:       releaseValues,
+		StringValues: releaseStringValues,
+		ValueFiles:   releaseValueFiles,
+	}
+	overrides, err := opts.MergeValues(getter.All(settings))
+	if err != nil {
+		return fmt.Errorf("invalid values for release %s: %v", release, err)
+	}
+	merged, err := chartutil.CoalesceValues(chart, overrides)
+	if err != nil {
+		return fmt.Errorf("invalid values for release %s: %v", release, err)
+	}
+	if err := chartutil.ValidateAgainstSchema(chart, merged); err != nil {
+		return fmt.Errorf("values for release %s do not match the %s chart's values schema:\n%v", release, chart.Name(), err)
+	}
+	return nil
+}
+
+// findReleaseChart returns the directory of the chart for the given release within the context directory
+func findReleaseChart(dir string, release string) (string, bool) {
+	chartDir := filepath.Join(dir, release)
+	if ok, _ := chartutil.IsChartDir(chartDir); ok {
+		return chartDir, true
+	}
+	if ok, _ := chartutil.IsChartDir(dir); ok {
+		if metadata, err := chartutil.LoadChartfile(filepath.Join(dir, chartutil.ChartfileName)); err == nil && metadata.Name == release {
+			return dir, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,82 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	gocontext "context"
+	"encoding/json"
+
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// storageLabels are the labels set on release secrets by the Helm storage driver, which can't be overridden
+var storageLabels = map[string]bool{
+	"name":       true,
+	"owner":      true,
+	"status":     true,
+	"version":    true,
+	"createdAt":  true,
+	"modifiedAt": true,
+}
+
+// labelingDriver is a Helm storage driver that adds the given labels to the secrets in which releases are stored
+// The labels are returned in the release's Labels when releases are listed, so releases installed by a previous
+// test or benchmark run can be selected by the labels of the run's pods.
+type labelingDriver struct {
+	driver.Driver
+	secrets typedcorev1.SecretInterface
+	labels  map[string]string
+}
+
+func (d *labelingDriver) Create(key string, rls *release.Release) error {
+	if err := d.Driver.Create(key, rls); err != nil {
+		return err
+	}
+	return d.label(key)
+}
+
+func (d *labelingDriver) Update(key string, rls *release.Release) error {
+	if err := d.Driver.Update(key, rls); err != nil {
+		return err
+	}
+	return d.label(key)
+}
+
+// label adds the driver's labels to the release secret with the given name
+func (d *labelingDriver) label(key string) error {
+	labels := make(map[string]string)
+	for name, value := range d.labels {
+		if !storageLabels[name] {
+			labels[name] = value
+		}
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": labels,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = d.secrets.Patch(gocontext.Background(), key, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
@@ -22,8 +22,34 @@ func NewContext(name string, args map[string]string) *Context {
 	}
 }
 
+// NewWorkerContext returns a new context for the given worker of a set of workers
+func NewWorkerContext(name string, args map[string]string, worker int, workers int) *Context {
+	return &Context{
+		Name:    name,
+		args:    args,
+		worker:  worker,
+		workers: workers,
+	}
+}
+
 // Context provides the test context
 type Context struct {
-	Name string
-	args map[string]string
+	Name    string
+	args    map[string]string
+	worker  int
+	workers int
+}
+
+// Worker returns the index of the worker running the context
+func (c *Context) Worker() int {
+	return c.worker
+}
+
+// Workers returns the total number of workers
+// If the context is not run by a set of workers, the context is run by a single worker.
+func (c *Context) Workers() int {
+	if c.workers == 0 {
+		return 1
+	}
+	return c.workers
 }
@@ -18,11 +18,16 @@ import "os"
 
 // Run runs the job
 func Run(job *Job) error {
-	coordinator := newRunner(job.Namespace, false)
-	status, err := coordinator.RunJob(job)
+	status, err := RunForStatus(job)
 	if err != nil {
 		return err
 	}
 	os.Exit(status)
 	return nil
 }
+
+// RunForStatus runs the job and returns its exit status
+func RunForStatus(job *Job) (int, error) {
+	coordinator := newRunner(job.Namespace, false)
+	return coordinator.RunJob(job)
+}
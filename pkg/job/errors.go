@@ -0,0 +1,61 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodStartFailed is returned when a job's pod never reaches the running state
+type PodStartFailed struct {
+	// Job is the ID of the job
+	Job string
+	// Pod is the name of the job's pod, or an empty string if no pod was created
+	Pod string
+	// Phase is the phase of the job's pod
+	Phase corev1.PodPhase
+	// Reason is a brief reason the pod failed to start, e.g. ImagePullBackOff
+	Reason string
+	// Message is a description of the reason the pod failed to start
+	Message string
+	// Events are the warning events recorded for the job and its pod
+	Events []string
+}
+
+func (e *PodStartFailed) Error() string {
+	cause := e.Reason
+	if e.Message != "" {
+		cause = fmt.Sprintf("%s: %s", e.Reason, e.Message)
+	}
+	var b strings.Builder
+	if e.Pod == "" {
+		fmt.Fprintf(&b, "job %s failed to create a pod: %s", e.Job, cause)
+	} else {
+		fmt.Fprintf(&b, "pod %s failed to start (%s): %s", e.Pod, e.Phase, cause)
+	}
+	for _, event := range e.Events {
+		fmt.Fprintf(&b, "\n  %s", event)
+	}
+	return b.String()
+}
+
+// IsPodStartFailed returns whether the given error is a PodStartFailed error
+func IsPodStartFailed(err error) bool {
+	_, ok := err.(*PodStartFailed)
+	return ok
+}
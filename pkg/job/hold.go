@@ -0,0 +1,99 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onosproject/helmit/pkg/util/logging"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const pauseImage = "k8s.gcr.io/pause:3.2"
+
+// getHoldName returns the name of the hold pod and release sentinel for the given job
+func getHoldName(job *Job) string {
+	return fmt.Sprintf("%s-hold", job.ID)
+}
+
+// hold holds the job's namespace open with a pause pod until the hold duration expires
+// or the release sentinel ConfigMap is created
+func (n *Runner) hold(job *Job) error {
+	step := logging.NewStep(job.ID, "Hold namespace %s for %s", n.Namespace(), job.Hold)
+	step.Start()
+
+	jobObj, err := n.Clientset().BatchV1().Jobs(n.Namespace()).Get(context.Background(), job.ID, metav1.GetOptions{})
+	if err != nil {
+		step.Fail(err)
+		return err
+	}
+
+	name := getHoldName(job)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: n.Namespace(),
+			Labels: map[string]string{
+				"job":  job.ID,
+				"type": job.Type,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Name:       jobObj.Name,
+					UID:        jobObj.UID,
+					Kind:       "Job",
+					APIVersion: "batch/v1",
+				},
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "pause",
+					Image: pauseImage,
+				},
+			},
+		},
+	}
+	if _, err := n.Clientset().CoreV1().Pods(n.Namespace()).Create(context.Background(), pod, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+		step.Fail(err)
+		return err
+	}
+
+	fmt.Printf("Namespace %s is held until %s\n", n.Namespace(), time.Now().Add(job.Hold).Format(time.RFC3339))
+	fmt.Printf("  Inspect the namespace:  kubectl get all -n %s\n", n.Namespace())
+	fmt.Printf("  Release the namespace:  kubectl create configmap %s -n %s\n", name, n.Namespace())
+
+	deadline := time.Now().Add(job.Hold)
+	for time.Now().Before(deadline) {
+		_, err := n.Clientset().CoreV1().ConfigMaps(n.Namespace()).Get(context.Background(), name, metav1.GetOptions{})
+		if err == nil {
+			_ = n.Clientset().CoreV1().ConfigMaps(n.Namespace()).Delete(context.Background(), name, metav1.DeleteOptions{})
+			break
+		} else if !k8serrors.IsNotFound(err) {
+			step.Fail(err)
+			return err
+		}
+		time.Sleep(time.Second)
+	}
+
+	_ = n.Clientset().CoreV1().Pods(n.Namespace()).Delete(context.Background(), name, metav1.DeleteOptions{})
+	step.Complete()
+	return nil
+}
@@ -26,6 +26,10 @@ import (
 const configPath = "/etc/helmit"
 const configFile = "job.json"
 const readyFile = "/tmp/job-ready"
+const serverReadyFile = "/tmp/server-ready"
+
+// DefaultPort is the port on which server jobs serve requests if no port is configured
+const DefaultPort = 5000
 
 // Config is a job configuration
 type Config struct {
@@ -39,12 +43,33 @@ type Config struct {
 	Executable      string
 	Context         string
 	Values          map[string][]string
+	StringValues    map[string][]string
 	ValueFiles      map[string][]string
 	Args            []string
 	Env             map[string]string
 	Timeout         time.Duration
 	NoTeardown      bool
 	Secrets         map[string]string
+	Hold            time.Duration
+	// Subdomain is the name of a headless service through which the job's pod is addressed by its
+	// stable DNS name <id>.<subdomain> in place of a per-job service
+	Subdomain string
+	// WaitContainers are the names of containers in the job's pod, e.g. sidecars injected on admission,
+	// that must be ready before the job is started
+	WaitContainers []string
+	// OutputFiles maps the names of output streams to local files. Lines of the job's output tagged with
+	// one of the streams are written to the stream's file in place of stdout.
+	OutputFiles map[string]string
+	// Port is the port on which a server job serves requests, or 0 to use the DefaultPort
+	Port int
+}
+
+// getPort returns the port on which the job serves requests
+func (c *Config) getPort() int {
+	if c.Port > 0 {
+		return c.Port
+	}
+	return DefaultPort
 }
 
 // Job is a job configuration
@@ -76,6 +101,13 @@ func isReady() bool {
 	return err == nil && !info.IsDir()
 }
 
+// SetServerReady marks the job's server as ready to serve requests
+// Server jobs are not considered ready by Kubernetes, and thus are not added to their Service's
+// endpoints, until the server has been marked ready.
+func SetServerReady() error {
+	return ioutil.WriteFile(serverReadyFile, []byte{}, 0644)
+}
+
 // LoadConfig returns the job configuration
 func LoadConfig(config interface{}) error {
 	file, err := os.Open(filepath.Join(configPath, configFile))
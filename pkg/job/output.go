@@ -0,0 +1,115 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// StdoutStream is the name of the standard output stream
+	StdoutStream = "stdout"
+	// StderrStream is the name of the standard error stream
+	StderrStream = "stderr"
+	// ProgressStream is the name of the stream of progress lines, which are displayed as a single live status line
+	ProgressStream = "progress"
+)
+
+// FormatOutput tags a line of captured output with the stream and test that wrote it
+// Output written outside of a test is tagged with the stream only.
+func FormatOutput(stream, test, line string) string {
+	if test == "" {
+		return fmt.Sprintf("[%s] %s", stream, line)
+	}
+	return fmt.Sprintf("[%s %s] %s", stream, test, line)
+}
+
+// isStderrOutput returns whether the given line of job output was tagged as written to stderr
+func isStderrOutput(line string) bool {
+	return strings.HasPrefix(line, "["+StderrStream+"]") || strings.HasPrefix(line, "["+StderrStream+" ")
+}
+
+// getProgressOutput returns the progress line from the given line of job output if it's tagged as progress
+func getProgressOutput(line string) (string, bool) {
+	prefix := "[" + ProgressStream + "] "
+	if !strings.HasPrefix(line, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(line, prefix), true
+}
+
+// outputFiles writes lines of job output tagged with an output stream to the stream's local file
+type outputFiles struct {
+	paths map[string]string
+	files map[string]*os.File
+	// continued is the file to which the remaining fragments of a partially read line are written
+	continued *os.File
+}
+
+func newOutputFiles(paths map[string]string) *outputFiles {
+	return &outputFiles{
+		paths: paths,
+		files: make(map[string]*os.File),
+	}
+}
+
+// write writes the given line to its stream's file and returns whether the line was written
+// Files are created or truncated when the first line is written to them. If isPrefix is set, the line
+// is a fragment of a longer line, and subsequent fragments are appended to the same file until the
+// end of the line is written.
+func (o *outputFiles) write(line string, isPrefix bool) (bool, error) {
+	if o.continued != nil {
+		file := o.continued
+		if !isPrefix {
+			o.continued = nil
+			_, err := fmt.Fprintln(file, line)
+			return true, err
+		}
+		_, err := fmt.Fprint(file, line)
+		return true, err
+	}
+	for stream, path := range o.paths {
+		prefix := "[" + stream + "] "
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+		file, ok := o.files[stream]
+		if !ok {
+			var err error
+			file, err = os.Create(path)
+			if err != nil {
+				return true, err
+			}
+			o.files[stream] = file
+		}
+		if isPrefix {
+			o.continued = file
+			_, err := fmt.Fprint(file, strings.TrimPrefix(line, prefix))
+			return true, err
+		}
+		_, err := fmt.Fprintln(file, strings.TrimPrefix(line, prefix))
+		return true, err
+	}
+	return false, nil
+}
+
+// close closes the output files
+func (o *outputFiles) close() {
+	for _, file := range o.files {
+		_ = file.Close()
+	}
+}
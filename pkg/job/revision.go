@@ -0,0 +1,46 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import "os"
+
+const (
+	// RevisionEnv is the environment variable containing the git commit of the code under test
+	RevisionEnv = "HELMIT_REVISION"
+	// RevisionDirtyEnv is the environment variable indicating whether the working tree had uncommitted changes
+	RevisionDirtyEnv = "HELMIT_REVISION_DIRTY"
+	// RevisionLabel is the label containing the git commit of the code under test
+	RevisionLabel = "revision"
+	// RevisionDirtyLabel is the label indicating whether the working tree had uncommitted changes
+	RevisionDirtyLabel = "revision-dirty"
+	// UnknownRevision is recorded when the code under test is not in a git repository
+	UnknownRevision = "unknown"
+)
+
+// Revision is the git revision of the code under test
+type Revision struct {
+	// Commit is the commit checked out in the working tree, or UnknownRevision
+	Commit string
+	// Dirty indicates whether the working tree had uncommitted changes
+	Dirty bool
+}
+
+// GetRevision returns the revision of the code under test recorded in the job's environment
+func GetRevision() Revision {
+	return Revision{
+		Commit: os.Getenv(RevisionEnv),
+		Dirty:  os.Getenv(RevisionDirtyEnv) == "true",
+	}
+}
@@ -19,7 +19,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -27,6 +31,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/onosproject/helmit/pkg/kubernetes"
+	kubeconfig "github.com/onosproject/helmit/pkg/kubernetes/config"
 	"github.com/onosproject/helmit/pkg/util/files"
 	"github.com/onosproject/helmit/pkg/util/logging"
 	batchv1 "k8s.io/api/batch/v1"
@@ -34,13 +39,20 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 )
 
 const defaultServiceAccountName = "cluster-test"
 const defaultRoleBindingName = "cluster-test"
 const defaultRoleName = "cluster-admin"
 const helmitSecretsName = "helmit-secrets"
+const maxLogLineSize = 64 * 1024
+
+// logStreamTimeout is the maximum time to wait for a job's output to be streamed once the job has exited
+const logStreamTimeout = 10 * time.Second
+
+// containersReadyTimeout is the maximum time to wait for a job's wait containers to be ready if the job has no timeout
+const containersReadyTimeout = 5 * time.Minute
 
 // NewNamespace returns a new job namespace
 func NewNamespace(namespace string) *Runner {
@@ -50,8 +62,9 @@ func NewNamespace(namespace string) *Runner {
 // newRunner returns a new job runner
 func newRunner(namespace string, server bool) *Runner {
 	return &Runner{
-		Client: kubernetes.NewForNamespaceOrDie(namespace),
-		server: server,
+		Client:  kubernetes.NewForNamespaceOrDie(namespace),
+		server:  server,
+		streams: make(map[string]chan struct{}),
 	}
 }
 
@@ -60,6 +73,8 @@ type Runner struct {
 	kubernetes.Client
 	server     bool
 	noTeardown bool
+	streams    map[string]chan struct{}
+	streamsMu  sync.Mutex
 }
 
 // RunJob runs the given job
@@ -77,7 +92,20 @@ func (n *Runner) StartJob(job *Job) error {
 	if err := n.startJob(job); err != nil {
 		return err
 	}
-	go n.streamLogs(job)
+	if len(job.OutputFiles) == 0 {
+		go n.streamLogs(job)
+		return nil
+	}
+
+	// Track the output of jobs with output files so WaitForExit can wait for it to be written
+	doneCh := make(chan struct{})
+	n.streamsMu.Lock()
+	n.streams[job.ID] = doneCh
+	n.streamsMu.Unlock()
+	go func() {
+		defer close(doneCh)
+		n.streamLogs(job)
+	}()
 	return nil
 }
 
@@ -103,16 +131,89 @@ func (n *Runner) streamLogs(job *Job) {
 	}
 	defer reader.Close()
 
-	// Stream the logs to stdout
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		logging.Print(scanner.Text())
+	files := newOutputFiles(job.OutputFiles)
+	defer files.close()
+
+	// Stream the logs to stdout a line at a time. Lines longer than the reader's buffer are written
+	// in fragments to bound memory usage regardless of the volume of output. Only the first fragment
+	// of a line carries its tag, so the remaining fragments are written to the same stream as the first
+	// without breaking the line, so lines remain intact when output is relayed through another job.
+	// Captured output tagged as written to stderr is streamed to stderr with its tag so it remains
+	// distinguishable. Output tagged with a stream that's written to a file is written to the file in
+	// place of stdout. Progress output is displayed as a single live status line.
+	lines := bufio.NewReaderSize(reader, maxLogLineSize)
+	var continued *os.File
+	for {
+		line, isPrefix, err := lines.ReadLine()
+		if err != nil {
+			return
+		}
+		if continued != nil {
+			writeFragment(continued, string(line), isPrefix)
+		} else if ok, err := files.write(string(line), isPrefix); ok {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		} else if progress, ok := getProgressOutput(string(line)); ok {
+			logging.PrintProgress(progress)
+		} else if isStderrOutput(string(line)) || isPrefix {
+			logging.ClearProgress()
+			continued = os.Stdout
+			if isStderrOutput(string(line)) {
+				continued = os.Stderr
+			}
+			writeFragment(continued, string(line), isPrefix)
+		} else {
+			logging.Print(string(line))
+		}
+		if !isPrefix {
+			continued = nil
+		}
+	}
+}
+
+// writeFragment writes a fragment of a line to the given file, ending the line unless isPrefix is set
+func writeFragment(file *os.File, fragment string, isPrefix bool) {
+	if isPrefix {
+		fmt.Fprint(file, fragment)
+	} else {
+		fmt.Fprintln(file, fragment)
+	}
+}
+
+// GetLogs returns the last lines of the output of the given job's pod
+// If lines is zero, the complete output is returned. The output of pods that have terminated can be retrieved
+// until the job is deleted.
+func (n *Runner) GetLogs(job *Job, lines int64) (string, error) {
+	pod, err := n.getPod(job, func(pod corev1.Pod) bool {
+		return true
+	})
+	if err != nil {
+		return "", err
+	} else if pod == nil {
+		return "", fmt.Errorf("no pod found for job %s", job.ID)
+	}
+
+	options := &corev1.PodLogOptions{
+		Container: "job",
+	}
+	if lines > 0 {
+		options.TailLines = &lines
+	}
+	bytes, err := n.Clientset().CoreV1().Pods(n.Namespace()).GetLogs(pod.Name, options).DoRaw(context.Background())
+	if err != nil {
+		return "", err
 	}
+	return string(bytes), nil
 }
 
 // WaitForExit waits for the job to exit
 func (n *Runner) WaitForExit(job *Job) (int, error) {
 	_, status, err := n.getStatus(job)
+	n.awaitLogs(job)
+	if err == nil && job.Hold > 0 {
+		_ = n.hold(job)
+	}
 	_ = n.finishJob(job)
 	if err != nil {
 		return 0, err
@@ -120,6 +221,23 @@ func (n *Runner) WaitForExit(job *Job) (int, error) {
 	return status, nil
 }
 
+// awaitLogs waits for the job's output to be streamed once the job has exited
+// Output streamed to files would be incomplete if the job were deleted before its output was read, so
+// WaitForExit waits for the output of jobs with output files for up to logStreamTimeout.
+func (n *Runner) awaitLogs(job *Job) {
+	n.streamsMu.Lock()
+	doneCh, ok := n.streams[job.ID]
+	delete(n.streams, job.ID)
+	n.streamsMu.Unlock()
+	if !ok {
+		return
+	}
+	select {
+	case <-doneCh:
+	case <-time.After(logStreamTimeout):
+	}
+}
+
 // setupRBAC sets up role based access controls for the cluster
 func (n *Runner) setupRBAC(job *Job) error {
 	step := logging.NewStep(n.Namespace(), "Configuring Service Account and RBAC using %s role", defaultRoleName)
@@ -253,6 +371,10 @@ func (n *Runner) startJob(job *Job) error {
 		step.Fail(err)
 		return err
 	}
+	if err := n.awaitContainersReady(job); err != nil {
+		step.Fail(err)
+		return err
+	}
 	if err := n.runJob(job); err != nil {
 		step.Fail(err)
 		return err
@@ -338,7 +460,7 @@ func (n *Runner) createJob(job *Job) error {
 		containerPorts = []corev1.ContainerPort{
 			{
 				Name:          "management",
-				ContainerPort: 5000,
+				ContainerPort: int32(job.getPort()),
 			},
 		}
 	}
@@ -347,8 +469,11 @@ func (n *Runner) createJob(job *Job) error {
 	if n.server {
 		readinessProbe = &corev1.Probe{
 			Handler: corev1.Handler{
-				TCPSocket: &corev1.TCPSocketAction{
-					Port: intstr.FromInt(5000),
+				Exec: &corev1.ExecAction{
+					Command: []string{
+						"stat",
+						serverReadyFile,
+					},
 				},
 			},
 			PeriodSeconds:    1,
@@ -381,6 +506,12 @@ func (n *Runner) createJob(job *Job) error {
 	labels["job"] = job.ID
 	labels["type"] = job.Type
 
+	// Pass the pod's labels to the job so they can be applied to the Helm releases it installs
+	env = append(env, corev1.EnvVar{
+		Name:  kubeconfig.LabelsEnv,
+		Value: k8slabels.Set(labels).String(),
+	})
+
 	annotations := job.Annotations
 	if annotations == nil {
 		annotations = make(map[string]string)
@@ -427,6 +558,11 @@ func (n *Runner) createJob(job *Job) error {
 		},
 	}
 
+	if job.Subdomain != "" {
+		batchJob.Spec.Template.Spec.Hostname = job.ID
+		batchJob.Spec.Template.Spec.Subdomain = job.Subdomain
+	}
+
 	if job.Timeout > 0 {
 		timeoutSeconds := int64(job.Timeout / time.Second)
 		batchJob.Spec.ActiveDeadlineSeconds = &timeoutSeconds
@@ -470,11 +606,11 @@ func (n *Runner) createJob(job *Job) error {
 		return err
 	}
 
-	if n.server {
+	if n.server && job.Subdomain == "" {
 		servicePorts := []corev1.ServicePort{
 			{
 				Name: "management",
-				Port: 5000,
+				Port: int32(job.getPort()),
 			},
 		}
 		svc := &corev1.Service{
@@ -511,22 +647,242 @@ func (n *Runner) createJob(job *Job) error {
 	return nil
 }
 
+// CreateHeadlessService creates a headless service selecting the pods with the given labels on the given port
+// Jobs with a Subdomain matching the service name are addressable by their stable pod DNS names.
+func (n *Runner) CreateHeadlessService(name string, selector map[string]string, port int) error {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: selector,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  selector,
+			Ports: []corev1.ServicePort{
+				{
+					Name: "management",
+					Port: int32(port),
+				},
+			},
+		},
+	}
+	_, err := n.Clientset().CoreV1().Services(n.Namespace()).Create(context.Background(), svc, metav1.CreateOptions{})
+	return err
+}
+
+// DeleteService deletes the service with the given name
+func (n *Runner) DeleteService(name string) error {
+	err := n.Clientset().CoreV1().Services(n.Namespace()).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // awaitJobRunning blocks until the test job creates a pod in the RUNNING state
+// If the pod cannot be started or the job fails before its pod is running, a PodStartFailed error describing
+// the cause is returned.
 func (n *Runner) awaitJobRunning(job *Job) error {
 	for {
 		pod, err := n.getPod(job, func(pod corev1.Pod) bool {
-			return len(pod.Status.ContainerStatuses) > 0 &&
-				pod.Status.ContainerStatuses[0].State.Running != nil
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if pod != nil {
+			if len(pod.Status.ContainerStatuses) > 0 {
+				state := pod.Status.ContainerStatuses[0].State
+				if state.Running != nil {
+					return nil
+				}
+				if isContainerFailed(state) {
+					return n.newPodStartFailed(job, pod, state.Waiting.Reason, state.Waiting.Message)
+				}
+			}
+			if pod.Status.Phase == corev1.PodFailed {
+				return n.newPodStartFailed(job, pod, pod.Status.Reason, pod.Status.Message)
+			}
+		}
+
+		// If the job failed, e.g. because its deadline was exceeded while the pod was unschedulable,
+		// the pod will never be started
+		batchJob, err := n.Clientset().BatchV1().Jobs(n.Namespace()).Get(context.Background(), job.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, condition := range batchJob.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+				return n.newPodStartFailed(job, pod, condition.Reason, condition.Message)
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// newPodStartFailed returns a PodStartFailed error for the given job and pod, which may be nil
+// Warning events recorded for the job and the pod are included in the error. If the pod is pending because it
+// cannot be scheduled, or the job failed to create a pod, the scheduling or creation failure is reported as
+// the cause in place of the given reason.
+func (n *Runner) newPodStartFailed(job *Job, pod *corev1.Pod, reason, message string) *PodStartFailed {
+	err := &PodStartFailed{
+		Job:     job.ID,
+		Reason:  reason,
+		Message: message,
+	}
+	names := []string{job.ID}
+	if pod != nil {
+		err.Pod = pod.Name
+		err.Phase = pod.Status.Phase
+		names = append(names, pod.Name)
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && pod.Status.Phase == corev1.PodPending {
+				err.Reason, err.Message = condition.Reason, condition.Message
+			}
+		}
+	}
+
+	events, eventsErr := n.Clientset().CoreV1().Events(n.Namespace()).List(context.Background(), metav1.ListOptions{})
+	if eventsErr != nil {
+		return err
+	}
+	items := events.Items
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].LastTimestamp.Before(&items[j].LastTimestamp)
+	})
+	for _, event := range items {
+		if event.Type != corev1.EventTypeWarning {
+			continue
+		}
+		for _, name := range names {
+			if event.InvolvedObject.Name == name {
+				err.Events = append(err.Events, fmt.Sprintf("%s %s/%s %s: %s", event.LastTimestamp.Format("15:04:05"),
+					strings.ToLower(event.InvolvedObject.Kind), name, event.Reason, event.Message))
+				if pod == nil && event.Reason == "FailedCreate" {
+					err.Reason, err.Message = event.Reason, event.Message
+				}
+			}
+		}
+	}
+	return err
+}
+
+// isContainerFailed returns whether the given container state indicates the container cannot be started
+func isContainerFailed(state corev1.ContainerState) bool {
+	if state.Waiting == nil {
+		return false
+	}
+	switch state.Waiting.Reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+		return true
+	}
+	return false
+}
+
+// awaitContainersReady blocks until the job's wait containers are ready
+// An error is returned if any of the containers is not in the job's pod, if a container cannot be started,
+// or if the containers are not ready within the job's timeout, or containersReadyTimeout if the job has none.
+func (n *Runner) awaitContainersReady(job *Job) error {
+	if len(job.WaitContainers) == 0 {
+		return nil
+	}
+
+	step := logging.NewStep(job.ID, "Waiting for containers %s", strings.Join(job.WaitContainers, ", "))
+	step.Start()
+	timeout := job.Timeout
+	if timeout == 0 {
+		timeout = containersReadyTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		pod, err := n.getPod(job, func(pod corev1.Pod) bool {
+			return true
 		})
 		if err != nil {
+			step.Fail(err)
 			return err
 		} else if pod != nil {
-			return nil
+			name, err := getUnreadyContainer(pod, job.WaitContainers)
+			if err != nil {
+				step.Fail(err)
+				return err
+			} else if name == "" {
+				step.Complete()
+				return nil
+			}
+			if state := getContainerState(pod, name); isContainerFailed(state) {
+				err := fmt.Errorf("container %s in pod %s failed to start: %s", name, pod.Name, formatContainerState(state))
+				step.Fail(err)
+				return err
+			} else if time.Now().After(deadline) {
+				err := fmt.Errorf("container %s in pod %s not ready after %s: %s", name, pod.Name, timeout, formatContainerState(state))
+				step.Fail(err)
+				return err
+			}
+		} else if time.Now().After(deadline) {
+			err := fmt.Errorf("containers %s not ready after %s: pod not found", strings.Join(job.WaitContainers, ", "), timeout)
+			step.Fail(err)
+			return err
 		}
 		time.Sleep(100 * time.Millisecond)
 	}
 }
 
+// getUnreadyContainer returns the name of the first of the named containers in the given pod that is not ready,
+// or an empty string if all the containers are ready
+func getUnreadyContainer(pod *corev1.Pod, names []string) (string, error) {
+	for _, name := range names {
+		found := false
+		for _, container := range pod.Spec.Containers {
+			if container.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("container %s not found in pod %s", name, pod.Name)
+		}
+
+		ready := false
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == name {
+				ready = status.Ready
+				break
+			}
+		}
+		if !ready {
+			return name, nil
+		}
+	}
+	return "", nil
+}
+
+// getContainerState returns the state of the named container in the given pod
+func getContainerState(pod *corev1.Pod, name string) corev1.ContainerState {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == name {
+			return status.State
+		}
+	}
+	return corev1.ContainerState{}
+}
+
+// formatContainerState returns a description of the given container state
+func formatContainerState(state corev1.ContainerState) string {
+	switch {
+	case state.Waiting != nil:
+		if state.Waiting.Message != "" {
+			return fmt.Sprintf("waiting (%s: %s)", state.Waiting.Reason, state.Waiting.Message)
+		}
+		return fmt.Sprintf("waiting (%s)", state.Waiting.Reason)
+	case state.Running != nil:
+		return fmt.Sprintf("running since %s, not ready", state.Running.StartedAt.Format(time.RFC3339))
+	case state.Terminated != nil:
+		return fmt.Sprintf("terminated (%s, exit code %d)", state.Terminated.Reason, state.Terminated.ExitCode)
+	}
+	return "not started"
+}
+
 // awaitJobReady blocks until the test job creates a ready pod
 func (n *Runner) awaitJobReady(job *Job) error {
 	for {
@@ -748,6 +1104,43 @@ func (n *Runner) getStatus(job *Job) (string, int, error) {
 	}
 }
 
+// GetPod returns the job's pod as stored by the API server
+// The returned pod reflects any mutations applied during admission, e.g. injected sidecars, labels,
+// and resource defaults. Admission happens when the pod is created, so the pod is returned without waiting
+// for it to be scheduled. If the job fails to create a pod, or the context is done before the pod is created,
+// a PodStartFailed error describing the cause is returned.
+func (n *Runner) GetPod(ctx context.Context, job *Job) (*corev1.Pod, error) {
+	for {
+		pod, err := n.getPod(job, func(pod corev1.Pod) bool {
+			return true
+		})
+		if err != nil {
+			return nil, err
+		} else if pod != nil {
+			return pod, nil
+		}
+
+		batchJob, err := n.Clientset().BatchV1().Jobs(n.Namespace()).Get(ctx, job.ID, metav1.GetOptions{})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, n.newPodStartFailed(job, nil, "PodNotCreated", ctx.Err().Error())
+			}
+			return nil, err
+		}
+		for _, condition := range batchJob.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+				return nil, n.newPodStartFailed(job, nil, condition.Reason, condition.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, n.newPodStartFailed(job, nil, "PodNotCreated", ctx.Err().Error())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
 // getPod finds the Pod for the given test
 func (n *Runner) getPod(job *Job, predicate func(pod corev1.Pod) bool) (*corev1.Pod, error) {
 	pods, err := n.Clientset().CoreV1().Pods(n.Namespace()).List(context.Background(), metav1.ListOptions{
@@ -777,6 +1170,11 @@ func (n *Runner) finishJob(job *Job) error {
 	return nil
 }
 
+// DeleteJob deletes the given job and its dependent resources
+func (n *Runner) DeleteJob(job *Job) error {
+	return n.deleteJob(job)
+}
+
 // deleteJob deletes a job
 func (n *Runner) deleteJob(job *Job) error {
 	step := logging.NewStep(job.ID, "Deleting job")
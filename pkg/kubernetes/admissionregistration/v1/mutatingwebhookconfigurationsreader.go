@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type MutatingWebhookConfigurationsReader interface {
 	Get(ctx context.Context, name string) (*MutatingWebhookConfiguration, error)
 	List(ctx context.Context) ([]*MutatingWebhookConfiguration, error)
+	AllNamespaces() MutatingWebhookConfigurationsReader
 }
 
 func NewMutatingWebhookConfigurationsReader(client resource.Client, filter resource.Filter) MutatingWebhookConfigurationsReader {
 	return &mutatingWebhookConfigurationsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type mutatingWebhookConfigurationsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *mutatingWebhookConfigurationsReader) AllNamespaces() MutatingWebhookConfigurationsReader {
+	return &mutatingWebhookConfigurationsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *mutatingWebhookConfigurationsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && MutatingWebhookConfigurationKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *mutatingWebhookConfigurationsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    MutatingWebhookConfigurationKind.Group,
+			Resource: MutatingWebhookConfigurationResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", MutatingWebhookConfigurationResource.Name, err))
+	}
+	return err
 }
 
 func (c *mutatingWebhookConfigurationsReader) Get(ctx context.Context, name string) (*MutatingWebhookConfiguration, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	mutatingWebhookConfiguration := &admissionregistrationv1.MutatingWebhookConfiguration{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *mutatingWebhookConfigurationsReader) Get(ctx context.Context, name stri
 	err = client.AdmissionregistrationV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), MutatingWebhookConfigurationKind.Scoped).
+		NamespaceIfScoped(c.namespace, MutatingWebhookConfigurationKind.Scoped).
 		Resource(MutatingWebhookConfigurationResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(mutatingWebhookConfiguration)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   MutatingWebhookConfigurationKind.Group,
@@ -75,14 +106,14 @@ func (c *mutatingWebhookConfigurationsReader) List(ctx context.Context) ([]*Muta
 	err = client.AdmissionregistrationV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), MutatingWebhookConfigurationKind.Scoped).
+		NamespaceIfScoped(c.namespace, MutatingWebhookConfigurationKind.Scoped).
 		Resource(MutatingWebhookConfigurationResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*MutatingWebhookConfiguration, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *mutatingWebhookConfigurationsReader) List(ctx context.Context) ([]*Muta
 	}
 	return results, nil
 }
+
+func (c *mutatingWebhookConfigurationsReader) getFromAllNamespaces(ctx context.Context, name string) (*MutatingWebhookConfiguration, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *MutatingWebhookConfiguration
+	for _, mutatingWebhookConfiguration := range list {
+		if mutatingWebhookConfiguration.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    MutatingWebhookConfigurationKind.Group,
+					Resource: MutatingWebhookConfigurationResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, mutatingWebhookConfiguration.Namespace))
+			}
+			result = mutatingWebhookConfiguration
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    MutatingWebhookConfigurationKind.Group,
+			Resource: MutatingWebhookConfigurationResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -8,7 +8,6 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var ValidatingWebhookConfigurationKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *ValidatingWebhookConfiguration) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.AdmissionregistrationV1().
+	err = client.AdmissionregistrationV1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, ValidatingWebhookConfigurationKind.Scoped).
 		Resource(ValidatingWebhookConfigurationResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type ValidatingWebhookConfigurationsReader interface {
 	Get(ctx context.Context, name string) (*ValidatingWebhookConfiguration, error)
 	List(ctx context.Context) ([]*ValidatingWebhookConfiguration, error)
+	AllNamespaces() ValidatingWebhookConfigurationsReader
 }
 
 func NewValidatingWebhookConfigurationsReader(client resource.Client, filter resource.Filter) ValidatingWebhookConfigurationsReader {
 	return &validatingWebhookConfigurationsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type validatingWebhookConfigurationsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *validatingWebhookConfigurationsReader) AllNamespaces() ValidatingWebhookConfigurationsReader {
+	return &validatingWebhookConfigurationsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *validatingWebhookConfigurationsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && ValidatingWebhookConfigurationKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *validatingWebhookConfigurationsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    ValidatingWebhookConfigurationKind.Group,
+			Resource: ValidatingWebhookConfigurationResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", ValidatingWebhookConfigurationResource.Name, err))
+	}
+	return err
 }
 
 func (c *validatingWebhookConfigurationsReader) Get(ctx context.Context, name string) (*ValidatingWebhookConfiguration, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	validatingWebhookConfiguration := &admissionregistrationv1.ValidatingWebhookConfiguration{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *validatingWebhookConfigurationsReader) Get(ctx context.Context, name st
 	err = client.AdmissionregistrationV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ValidatingWebhookConfigurationKind.Scoped).
+		NamespaceIfScoped(c.namespace, ValidatingWebhookConfigurationKind.Scoped).
 		Resource(ValidatingWebhookConfigurationResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(validatingWebhookConfiguration)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   ValidatingWebhookConfigurationKind.Group,
@@ -75,14 +106,14 @@ func (c *validatingWebhookConfigurationsReader) List(ctx context.Context) ([]*Va
 	err = client.AdmissionregistrationV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ValidatingWebhookConfigurationKind.Scoped).
+		NamespaceIfScoped(c.namespace, ValidatingWebhookConfigurationKind.Scoped).
 		Resource(ValidatingWebhookConfigurationResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*ValidatingWebhookConfiguration, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *validatingWebhookConfigurationsReader) List(ctx context.Context) ([]*Va
 	}
 	return results, nil
 }
+
+func (c *validatingWebhookConfigurationsReader) getFromAllNamespaces(ctx context.Context, name string) (*ValidatingWebhookConfiguration, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *ValidatingWebhookConfiguration
+	for _, validatingWebhookConfiguration := range list {
+		if validatingWebhookConfiguration.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    ValidatingWebhookConfigurationKind.Group,
+					Resource: ValidatingWebhookConfigurationResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, validatingWebhookConfiguration.Namespace))
+			}
+			result = validatingWebhookConfiguration
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ValidatingWebhookConfigurationKind.Group,
+			Resource: ValidatingWebhookConfigurationResource.Name,
+		}, name)
+	}
+	return result, nil
+}
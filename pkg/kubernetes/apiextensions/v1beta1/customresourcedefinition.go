@@ -8,7 +8,6 @@ import (
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	clientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"time"
 )
 
 var CustomResourceDefinitionKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *CustomResourceDefinition) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.ApiextensionsV1beta1().
+	err = client.ApiextensionsV1beta1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, CustomResourceDefinitionKind.Scoped).
 		Resource(CustomResourceDefinitionResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
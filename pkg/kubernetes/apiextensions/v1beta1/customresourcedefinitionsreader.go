@@ -4,33 +4,64 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	clientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"time"
 )
 
 type CustomResourceDefinitionsReader interface {
 	Get(ctx context.Context, name string) (*CustomResourceDefinition, error)
 	List(ctx context.Context) ([]*CustomResourceDefinition, error)
+	AllNamespaces() CustomResourceDefinitionsReader
 }
 
 func NewCustomResourceDefinitionsReader(client resource.Client, filter resource.Filter) CustomResourceDefinitionsReader {
 	return &customResourceDefinitionsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type customResourceDefinitionsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *customResourceDefinitionsReader) AllNamespaces() CustomResourceDefinitionsReader {
+	return &customResourceDefinitionsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *customResourceDefinitionsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && CustomResourceDefinitionKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *customResourceDefinitionsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    CustomResourceDefinitionKind.Group,
+			Resource: CustomResourceDefinitionResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", CustomResourceDefinitionResource.Name, err))
+	}
+	return err
 }
 
 func (c *customResourceDefinitionsReader) Get(ctx context.Context, name string) (*CustomResourceDefinition, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	customResourceDefinition := &apiextensionsv1beta1.CustomResourceDefinition{}
 	client, err := clientset.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *customResourceDefinitionsReader) Get(ctx context.Context, name string)
 	err = client.ApiextensionsV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), CustomResourceDefinitionKind.Scoped).
+		NamespaceIfScoped(c.namespace, CustomResourceDefinitionKind.Scoped).
 		Resource(CustomResourceDefinitionResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(customResourceDefinition)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   CustomResourceDefinitionKind.Group,
@@ -75,14 +106,14 @@ func (c *customResourceDefinitionsReader) List(ctx context.Context) ([]*CustomRe
 	err = client.ApiextensionsV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), CustomResourceDefinitionKind.Scoped).
+		NamespaceIfScoped(c.namespace, CustomResourceDefinitionKind.Scoped).
 		Resource(CustomResourceDefinitionResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*CustomResourceDefinition, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *customResourceDefinitionsReader) List(ctx context.Context) ([]*CustomRe
 	}
 	return results, nil
 }
+
+func (c *customResourceDefinitionsReader) getFromAllNamespaces(ctx context.Context, name string) (*CustomResourceDefinition, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *CustomResourceDefinition
+	for _, customResourceDefinition := range list {
+		if customResourceDefinition.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    CustomResourceDefinitionKind.Group,
+					Resource: CustomResourceDefinitionResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, customResourceDefinition.Namespace))
+			}
+			result = customResourceDefinition
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    CustomResourceDefinitionKind.Group,
+			Resource: CustomResourceDefinitionResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type DaemonSetsReader interface {
 	Get(ctx context.Context, name string) (*DaemonSet, error)
 	List(ctx context.Context) ([]*DaemonSet, error)
+	AllNamespaces() DaemonSetsReader
 }
 
 func NewDaemonSetsReader(client resource.Client, filter resource.Filter) DaemonSetsReader {
 	return &daemonSetsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type daemonSetsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *daemonSetsReader) AllNamespaces() DaemonSetsReader {
+	return &daemonSetsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *daemonSetsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && DaemonSetKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *daemonSetsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    DaemonSetKind.Group,
+			Resource: DaemonSetResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", DaemonSetResource.Name, err))
+	}
+	return err
 }
 
 func (c *daemonSetsReader) Get(ctx context.Context, name string) (*DaemonSet, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	daemonSet := &appsv1.DaemonSet{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *daemonSetsReader) Get(ctx context.Context, name string) (*DaemonSet, er
 	err = client.AppsV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), DaemonSetKind.Scoped).
+		NamespaceIfScoped(c.namespace, DaemonSetKind.Scoped).
 		Resource(DaemonSetResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(daemonSet)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   DaemonSetKind.Group,
@@ -75,14 +106,14 @@ func (c *daemonSetsReader) List(ctx context.Context) ([]*DaemonSet, error) {
 	err = client.AppsV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), DaemonSetKind.Scoped).
+		NamespaceIfScoped(c.namespace, DaemonSetKind.Scoped).
 		Resource(DaemonSetResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*DaemonSet, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *daemonSetsReader) List(ctx context.Context) ([]*DaemonSet, error) {
 	}
 	return results, nil
 }
+
+func (c *daemonSetsReader) getFromAllNamespaces(ctx context.Context, name string) (*DaemonSet, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *DaemonSet
+	for _, daemonSet := range list {
+		if daemonSet.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    DaemonSetKind.Group,
+					Resource: DaemonSetResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, daemonSet.Namespace))
+			}
+			result = daemonSet
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    DaemonSetKind.Group,
+			Resource: DaemonSetResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type DeploymentsReader interface {
 	Get(ctx context.Context, name string) (*Deployment, error)
 	List(ctx context.Context) ([]*Deployment, error)
+	AllNamespaces() DeploymentsReader
 }
 
 func NewDeploymentsReader(client resource.Client, filter resource.Filter) DeploymentsReader {
 	return &deploymentsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type deploymentsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *deploymentsReader) AllNamespaces() DeploymentsReader {
+	return &deploymentsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *deploymentsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && DeploymentKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *deploymentsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    DeploymentKind.Group,
+			Resource: DeploymentResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", DeploymentResource.Name, err))
+	}
+	return err
 }
 
 func (c *deploymentsReader) Get(ctx context.Context, name string) (*Deployment, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	deployment := &appsv1.Deployment{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *deploymentsReader) Get(ctx context.Context, name string) (*Deployment,
 	err = client.AppsV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), DeploymentKind.Scoped).
+		NamespaceIfScoped(c.namespace, DeploymentKind.Scoped).
 		Resource(DeploymentResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(deployment)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   DeploymentKind.Group,
@@ -75,14 +106,14 @@ func (c *deploymentsReader) List(ctx context.Context) ([]*Deployment, error) {
 	err = client.AppsV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), DeploymentKind.Scoped).
+		NamespaceIfScoped(c.namespace, DeploymentKind.Scoped).
 		Resource(DeploymentResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Deployment, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *deploymentsReader) List(ctx context.Context) ([]*Deployment, error) {
 	}
 	return results, nil
 }
+
+func (c *deploymentsReader) getFromAllNamespaces(ctx context.Context, name string) (*Deployment, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Deployment
+	for _, deployment := range list {
+		if deployment.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    DeploymentKind.Group,
+					Resource: DeploymentResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, deployment.Namespace))
+			}
+			result = deployment
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    DeploymentKind.Group,
+			Resource: DeploymentResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type ReplicaSetsReader interface {
 	Get(ctx context.Context, name string) (*ReplicaSet, error)
 	List(ctx context.Context) ([]*ReplicaSet, error)
+	AllNamespaces() ReplicaSetsReader
 }
 
 func NewReplicaSetsReader(client resource.Client, filter resource.Filter) ReplicaSetsReader {
 	return &replicaSetsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type replicaSetsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *replicaSetsReader) AllNamespaces() ReplicaSetsReader {
+	return &replicaSetsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *replicaSetsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && ReplicaSetKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *replicaSetsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    ReplicaSetKind.Group,
+			Resource: ReplicaSetResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", ReplicaSetResource.Name, err))
+	}
+	return err
 }
 
 func (c *replicaSetsReader) Get(ctx context.Context, name string) (*ReplicaSet, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	replicaSet := &appsv1.ReplicaSet{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *replicaSetsReader) Get(ctx context.Context, name string) (*ReplicaSet,
 	err = client.AppsV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ReplicaSetKind.Scoped).
+		NamespaceIfScoped(c.namespace, ReplicaSetKind.Scoped).
 		Resource(ReplicaSetResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(replicaSet)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   ReplicaSetKind.Group,
@@ -75,14 +106,14 @@ func (c *replicaSetsReader) List(ctx context.Context) ([]*ReplicaSet, error) {
 	err = client.AppsV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ReplicaSetKind.Scoped).
+		NamespaceIfScoped(c.namespace, ReplicaSetKind.Scoped).
 		Resource(ReplicaSetResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*ReplicaSet, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *replicaSetsReader) List(ctx context.Context) ([]*ReplicaSet, error) {
 	}
 	return results, nil
 }
+
+func (c *replicaSetsReader) getFromAllNamespaces(ctx context.Context, name string) (*ReplicaSet, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *ReplicaSet
+	for _, replicaSet := range list {
+		if replicaSet.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    ReplicaSetKind.Group,
+					Resource: ReplicaSetResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, replicaSet.Namespace))
+			}
+			result = replicaSet
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ReplicaSetKind.Group,
+			Resource: ReplicaSetResource.Name,
+		}, name)
+	}
+	return result, nil
+}
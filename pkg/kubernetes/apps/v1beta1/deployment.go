@@ -10,7 +10,6 @@ import (
 	appsv1beta1 "k8s.io/api/apps/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var DeploymentKind = resource.Kind{
@@ -46,14 +45,15 @@ func (r *Deployment) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.AppsV1beta1().
+	err = client.AppsV1beta1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, DeploymentKind.Scoped).
 		Resource(DeploymentResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
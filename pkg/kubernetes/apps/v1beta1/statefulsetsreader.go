@@ -4,33 +4,64 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	appsv1beta1 "k8s.io/api/apps/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type StatefulSetsReader interface {
 	Get(ctx context.Context, name string) (*StatefulSet, error)
 	List(ctx context.Context) ([]*StatefulSet, error)
+	AllNamespaces() StatefulSetsReader
 }
 
 func NewStatefulSetsReader(client resource.Client, filter resource.Filter) StatefulSetsReader {
 	return &statefulSetsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type statefulSetsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *statefulSetsReader) AllNamespaces() StatefulSetsReader {
+	return &statefulSetsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *statefulSetsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && StatefulSetKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *statefulSetsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    StatefulSetKind.Group,
+			Resource: StatefulSetResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", StatefulSetResource.Name, err))
+	}
+	return err
 }
 
 func (c *statefulSetsReader) Get(ctx context.Context, name string) (*StatefulSet, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	statefulSet := &appsv1beta1.StatefulSet{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *statefulSetsReader) Get(ctx context.Context, name string) (*StatefulSet
 	err = client.AppsV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), StatefulSetKind.Scoped).
+		NamespaceIfScoped(c.namespace, StatefulSetKind.Scoped).
 		Resource(StatefulSetResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(statefulSet)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   StatefulSetKind.Group,
@@ -75,14 +106,14 @@ func (c *statefulSetsReader) List(ctx context.Context) ([]*StatefulSet, error) {
 	err = client.AppsV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), StatefulSetKind.Scoped).
+		NamespaceIfScoped(c.namespace, StatefulSetKind.Scoped).
 		Resource(StatefulSetResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*StatefulSet, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *statefulSetsReader) List(ctx context.Context) ([]*StatefulSet, error) {
 	}
 	return results, nil
 }
+
+func (c *statefulSetsReader) getFromAllNamespaces(ctx context.Context, name string) (*StatefulSet, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *StatefulSet
+	for _, statefulSet := range list {
+		if statefulSet.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    StatefulSetKind.Group,
+					Resource: StatefulSetResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, statefulSet.Namespace))
+			}
+			result = statefulSet
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    StatefulSetKind.Group,
+			Resource: StatefulSetResource.Name,
+		}, name)
+	}
+	return result, nil
+}
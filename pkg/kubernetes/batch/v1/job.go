@@ -8,7 +8,6 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var JobKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *Job) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.BatchV1().
+	err = client.BatchV1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, JobKind.Scoped).
 		Resource(JobResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type JobsReader interface {
 	Get(ctx context.Context, name string) (*Job, error)
 	List(ctx context.Context) ([]*Job, error)
+	AllNamespaces() JobsReader
 }
 
 func NewJobsReader(client resource.Client, filter resource.Filter) JobsReader {
 	return &jobsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type jobsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *jobsReader) AllNamespaces() JobsReader {
+	return &jobsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *jobsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && JobKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *jobsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    JobKind.Group,
+			Resource: JobResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", JobResource.Name, err))
+	}
+	return err
 }
 
 func (c *jobsReader) Get(ctx context.Context, name string) (*Job, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	job := &batchv1.Job{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *jobsReader) Get(ctx context.Context, name string) (*Job, error) {
 	err = client.BatchV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), JobKind.Scoped).
+		NamespaceIfScoped(c.namespace, JobKind.Scoped).
 		Resource(JobResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(job)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   JobKind.Group,
@@ -75,14 +106,14 @@ func (c *jobsReader) List(ctx context.Context) ([]*Job, error) {
 	err = client.BatchV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), JobKind.Scoped).
+		NamespaceIfScoped(c.namespace, JobKind.Scoped).
 		Resource(JobResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Job, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *jobsReader) List(ctx context.Context) ([]*Job, error) {
 	}
 	return results, nil
 }
+
+func (c *jobsReader) getFromAllNamespaces(ctx context.Context, name string) (*Job, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Job
+	for _, job := range list {
+		if job.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    JobKind.Group,
+					Resource: JobResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, job.Namespace))
+			}
+			result = job
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    JobKind.Group,
+			Resource: JobResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -8,7 +8,6 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var CronJobKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *CronJob) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.BatchV1beta1().
+	err = client.BatchV1beta1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, CronJobKind.Scoped).
 		Resource(CronJobResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
@@ -4,33 +4,64 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type CronJobsReader interface {
 	Get(ctx context.Context, name string) (*CronJob, error)
 	List(ctx context.Context) ([]*CronJob, error)
+	AllNamespaces() CronJobsReader
 }
 
 func NewCronJobsReader(client resource.Client, filter resource.Filter) CronJobsReader {
 	return &cronJobsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type cronJobsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *cronJobsReader) AllNamespaces() CronJobsReader {
+	return &cronJobsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *cronJobsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && CronJobKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *cronJobsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    CronJobKind.Group,
+			Resource: CronJobResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", CronJobResource.Name, err))
+	}
+	return err
 }
 
 func (c *cronJobsReader) Get(ctx context.Context, name string) (*CronJob, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	cronJob := &batchv1beta1.CronJob{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *cronJobsReader) Get(ctx context.Context, name string) (*CronJob, error)
 	err = client.BatchV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), CronJobKind.Scoped).
+		NamespaceIfScoped(c.namespace, CronJobKind.Scoped).
 		Resource(CronJobResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(cronJob)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   CronJobKind.Group,
@@ -75,14 +106,14 @@ func (c *cronJobsReader) List(ctx context.Context) ([]*CronJob, error) {
 	err = client.BatchV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), CronJobKind.Scoped).
+		NamespaceIfScoped(c.namespace, CronJobKind.Scoped).
 		Resource(CronJobResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*CronJob, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *cronJobsReader) List(ctx context.Context) ([]*CronJob, error) {
 	}
 	return results, nil
 }
+
+func (c *cronJobsReader) getFromAllNamespaces(ctx context.Context, name string) (*CronJob, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *CronJob
+	for _, cronJob := range list {
+		if cronJob.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    CronJobKind.Group,
+					Resource: CronJobResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, cronJob.Namespace))
+			}
+			result = cronJob
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    CronJobKind.Group,
+			Resource: CronJobResource.Name,
+		}, name)
+	}
+	return result, nil
+}
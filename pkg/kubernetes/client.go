@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"time"
 )
 
 // New returns a new Kubernetes client for the current namespace
@@ -78,6 +79,11 @@ type Client interface {
 
 	// Clientset returns the client's Clientset
 	Clientset() *kubernetes.Clientset
+
+	// WaitForLogPattern waits until the logs of every container in the client's pods match the given pattern
+	WaitForLogPattern(ctx context.Context, pattern string, timeout time.Duration) error
+
+	// Group clients
 	AdmissionregistrationV1() admissionregistrationv1.Client
 	ApiextensionsV1() apiextensionsv1.Client
 	ApiextensionsV1beta1() apiextensionsv1beta1.Client
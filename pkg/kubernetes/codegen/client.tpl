@@ -15,6 +15,7 @@ import (
 	helmkube "helm.sh/helm/v3/pkg/kube"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"context"
+	"time"
 )
 
 // New returns a new Kubernetes client for the current namespace
@@ -69,6 +70,11 @@ type {{ .Types.Interface }} interface {
 	// Clientset returns the client's Clientset
 	Clientset() *kubernetes.Clientset
 
+	// WaitForLogPattern waits until the logs of every container in the client's pods match the given pattern
+	WaitForLogPattern(ctx context.Context, pattern string, timeout time.Duration) error
+
+	// Group clients
+
     {{- range $name, $group := .Groups }}
     {{ $group.Names.Proper }}() {{ $group.Package.Alias }}.{{ $group.Types.Interface }}
     {{- end }}
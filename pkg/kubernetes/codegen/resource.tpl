@@ -16,7 +16,6 @@ import (
     {{ $ref.Reference.Package.Alias }} {{ $ref.Reference.Package.Path | quote }}
     {{- end }}
     {{- end }}
-    "time"
     "context"
 )
 
@@ -67,14 +66,15 @@ func (r *{{ $resource.Types.Struct }}) Delete(ctx context.Context) error {
     if err != nil {
         return err
     }
-	return client.{{ .Group.Names.Proper }}().
+	err = client.{{ .Group.Names.Proper }}().
         RESTClient().
 	    Delete().
 	    NamespaceIfScoped(r.Namespace, {{ .Resource.Types.Kind }}.Scoped).
 		Resource({{ .Resource.Types.Resource }}.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
@@ -9,25 +9,53 @@ import (
 	{{ .Resource.Kind.Package.Alias }} {{ .Resource.Kind.Package.Path | quote }}
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"time"
 	"context"
+	"fmt"
 )
 
 type {{ .Reader.Types.Interface }} interface {
 	Get(ctx context.Context, name string) (*{{ .Resource.Types.Struct }}, error)
 	List(ctx context.Context) ([]*{{ .Resource.Types.Struct }}, error)
+	AllNamespaces() {{ .Reader.Types.Interface }}
 }
 
 func New{{ .Reader.Types.Interface }}(client resource.Client, filter resource.Filter) {{ .Reader.Types.Interface }} {
 	return &{{ .Reader.Types.Struct }}{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type {{ .Reader.Types.Struct }} struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *{{ .Reader.Types.Struct }}) AllNamespaces() {{ .Reader.Types.Interface }} {
+	return &{{ .Reader.Types.Struct }}{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *{{ .Reader.Types.Struct }}) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && {{ .Resource.Types.Kind }}.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *{{ .Reader.Types.Struct }}) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    {{ .Resource.Types.Kind }}.Group,
+			Resource: {{ .Resource.Types.Resource }}.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", {{ .Resource.Types.Resource }}.Name, err))
+	}
+	return err
 }
 
 {{- $singular := (.Resource.Names.Singular | toLowerCamel) }}
@@ -35,6 +63,9 @@ type {{ .Reader.Types.Struct }} struct {
 {{- $listKind := (printf "%s.%s" .Resource.Kind.Package.Alias .Resource.Kind.ListKind) }}
 
 func (c *{{ .Reader.Types.Struct }}) Get(ctx context.Context, name string) (*{{ .Resource.Types.Struct }}, error) {
+    if c.allNamespaces() {
+        return c.getFromAllNamespaces(ctx, name)
+    }
     {{ $singular }} := &{{ $kind }}{}
     client, err := {{ .Resource.Client.Package.Alias }}.NewForConfig(c.Config())
     if err != nil {
@@ -43,15 +74,15 @@ func (c *{{ .Reader.Types.Struct }}) Get(ctx context.Context, name string) (*{{
 	err = client.{{ .Group.Names.Proper }}().
         RESTClient().
 	    Get().
-	    NamespaceIfScoped(c.Namespace(), {{ .Resource.Types.Kind }}.Scoped).
+	    NamespaceIfScoped(c.namespace, {{ .Resource.Types.Kind }}.Scoped).
 		Resource({{ .Resource.Types.Resource }}.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into({{ $singular }})
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
         ok, err := c.filter(metav1.GroupVersionKind{
             Group:   {{ .Resource.Types.Kind }}.Group,
@@ -79,14 +110,14 @@ func (c *{{ .Reader.Types.Struct }}) List(ctx context.Context) ([]*{{ .Resource.
 	err = client.{{ .Group.Names.Proper }}().
         RESTClient().
 	    Get().
-	    NamespaceIfScoped(c.Namespace(), {{ .Resource.Types.Kind }}.Scoped).
+	    NamespaceIfScoped(c.namespace, {{ .Resource.Types.Kind }}.Scoped).
 		Resource({{ .Resource.Types.Resource }}.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*{{ .Resource.Types.Struct }}, 0, len(list.Items))
@@ -105,3 +136,29 @@ func (c *{{ .Reader.Types.Struct }}) List(ctx context.Context) ([]*{{ .Resource.
 	}
 	return results, nil
 }
+
+func (c *{{ .Reader.Types.Struct }}) getFromAllNamespaces(ctx context.Context, name string) (*{{ .Resource.Types.Struct }}, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *{{ .Resource.Types.Struct }}
+	for _, {{ $singular }} := range list {
+		if {{ $singular }}.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    {{ .Resource.Types.Kind }}.Group,
+					Resource: {{ .Resource.Types.Resource }}.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, {{ $singular }}.Namespace))
+			}
+			result = {{ $singular }}
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    {{ .Resource.Types.Kind }}.Group,
+			Resource: {{ .Resource.Types.Resource }}.Name,
+		}, name)
+	}
+	return result, nil
+}
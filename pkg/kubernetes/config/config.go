@@ -15,9 +15,12 @@
 package config
 
 import (
+	"fmt"
 	"github.com/onosproject/helmit/pkg/util/random"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"net/http"
 	"os"
 )
 
@@ -33,12 +36,37 @@ func GetNamespaceFromEnv() string {
 	return namespace
 }
 
+// LabelsEnv is the environment variable containing the labels of the job's pod
+const LabelsEnv = "HELMIT_LABELS"
+
+// GetLabelsFromEnv gets the labels of the job's pod from the environment
+func GetLabelsFromEnv() map[string]string {
+	set, err := labels.ConvertSelectorToLabelsMap(os.Getenv(LabelsEnv))
+	if err != nil {
+		return nil
+	}
+	return set
+}
+
 // GetRestConfigOrDie returns the Kubernetes REST API configuration
 func GetRestConfigOrDie() *rest.Config {
 	config, err := GetRestConfig()
 	if err != nil {
 		panic(err)
 	}
+	if os.Getenv(TraceRequestsEnv) != "" {
+		writer, err := getTraceWriter()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to open request trace: %v\n", err)
+		} else {
+			config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+				return &requestTracer{
+					writer: writer,
+					rt:     rt,
+				}
+			})
+		}
+	}
 	return config
 }
 
@@ -46,12 +74,30 @@ func GetRestConfigOrDie() *rest.Config {
 func GetRestConfig() (*rest.Config, error) {
 	restconfig, err := rest.InClusterConfig()
 	if err == nil {
-		return restconfig, nil
+		return WrapConfig(restconfig), nil
 	}
 
 	kubeconfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		clientcmd.NewDefaultClientConfigLoadingRules(),
 		&clientcmd.ConfigOverrides{},
 	)
-	return kubeconfig.ClientConfig()
+	restconfig, err = kubeconfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return WrapConfig(restconfig), nil
+}
+
+// WrapConfig wraps the transport of the given REST API configuration with the guardrails enabled in the environment
+func WrapConfig(config *rest.Config) *rest.Config {
+	if isNamespaceRestricted() {
+		namespace := os.Getenv(NamespaceEnv)
+		config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &namespaceRestrictor{
+				namespace: namespace,
+				rt:        rt,
+			}
+		})
+	}
+	return config
 }
@@ -0,0 +1,46 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// RestrictNamespaceEnv is the environment variable that restricts Kubernetes API requests to the namespace
+// set in NamespaceEnv
+const RestrictNamespaceEnv = "HELMIT_RESTRICT_NAMESPACE"
+
+// isNamespaceRestricted returns whether API requests are restricted to the namespace from the environment
+func isNamespaceRestricted() bool {
+	return os.Getenv(RestrictNamespaceEnv) == "true" && os.Getenv(NamespaceEnv) != ""
+}
+
+// namespaceRestrictor is an http.RoundTripper that fails requests targeting namespaces other than its own
+type namespaceRestrictor struct {
+	namespace string
+	rt        http.RoundTripper
+}
+
+// RoundTrip fails the request if it targets a namespace other than the restricted namespace
+// Cluster scoped requests are permitted.
+func (r *namespaceRestrictor) RoundTrip(request *http.Request) (*http.Response, error) {
+	if namespace := parseRequestPath(request.URL.Path).namespace; namespace != "" && namespace != r.namespace {
+		return nil, fmt.Errorf("%s %s targets namespace %s: requests are restricted to namespace %s",
+			request.Method, request.URL.Path, namespace, r.namespace)
+	}
+	return r.rt.RoundTrip(request)
+}
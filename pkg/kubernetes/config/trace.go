@@ -0,0 +1,204 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TraceRequestsEnv is the environment variable that enables tracing of Kubernetes API requests
+// If the value is "stdout", requests are printed to stdout. If the value is TraceStream, requests are written
+// to stdout as JSON lines tagged with the trace stream, from which the CLI writes them to a local file.
+// Otherwise, requests are appended to the file at the given path as JSON lines.
+const TraceRequestsEnv = "HELMIT_TRACE_REQUESTS"
+
+// TraceStream is the name of the output stream on which request traces are written for a local trace file
+const TraceStream = "trace"
+
+const traceStdout = "stdout"
+
+var traceWriter io.Writer
+var traceMu sync.Mutex
+
+// traceStreamWriter is the stdout of the process when it started, to which traces are streamed
+// Output captured by tests replaces os.Stdout, and traces streamed to the CLI must not be tagged as test output.
+var traceStreamWriter io.Writer = os.Stdout
+
+// requestTrace is a record of a single Kubernetes API request
+type requestTrace struct {
+	Time      time.Time     `json:"time"`
+	Method    string        `json:"method"`
+	Verb      string        `json:"verb"`
+	Resource  string        `json:"resource,omitempty"`
+	Namespace string        `json:"namespace,omitempty"`
+	Name      string        `json:"name,omitempty"`
+	Path      string        `json:"path"`
+	Status    int           `json:"status,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// getTraceWriter returns the writer to which request traces are written, opening the trace file if necessary
+func getTraceWriter() (io.Writer, error) {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	if traceWriter != nil {
+		return traceWriter, nil
+	}
+	target := os.Getenv(TraceRequestsEnv)
+	if target == traceStdout {
+		traceWriter = os.Stdout
+		return traceWriter, nil
+	}
+	if target == TraceStream {
+		traceWriter = traceStreamWriter
+		return traceWriter, nil
+	}
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	traceWriter = file
+	return traceWriter, nil
+}
+
+// requestTracer is an http.RoundTripper that records each Kubernetes API request
+type requestTracer struct {
+	writer io.Writer
+	rt     http.RoundTripper
+}
+
+// RoundTrip performs the request and records its verb, resource, namespace, and status
+func (t *requestTracer) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := t.rt.RoundTrip(request)
+
+	path := parseRequestPath(request.URL.Path)
+	trace := requestTrace{
+		Time:      start,
+		Method:    request.Method,
+		Verb:      path.verb(request),
+		Resource:  path.resource,
+		Namespace: path.namespace,
+		Name:      path.name,
+		Path:      request.URL.Path,
+		Duration:  time.Since(start),
+	}
+	if path.subresource != "" {
+		trace.Resource = fmt.Sprintf("%s/%s", path.resource, path.subresource)
+	}
+	if err != nil {
+		trace.Error = err.Error()
+	} else {
+		trace.Status = response.StatusCode
+	}
+
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	if t.writer == os.Stdout {
+		fmt.Fprintf(t.writer, "TRACE %s %s namespace=%s name=%s status=%d duration=%s\n",
+			trace.Verb, trace.Resource, trace.Namespace, trace.Name, trace.Status, trace.Duration)
+	} else if bytes, err := json.Marshal(trace); err == nil {
+		if t.writer == traceStreamWriter {
+			fmt.Fprintf(t.writer, "[%s] %s\n", TraceStream, bytes)
+		} else {
+			_, _ = t.writer.Write(append(bytes, '\n'))
+		}
+	}
+	return response, err
+}
+
+// requestPath is a parsed Kubernetes API request path
+type requestPath struct {
+	namespace   string
+	resource    string
+	name        string
+	subresource string
+	watch       bool
+}
+
+// verb returns the Kubernetes API verb for the given request to the path
+func (p requestPath) verb(request *http.Request) string {
+	switch request.Method {
+	case http.MethodGet:
+		if p.watch || request.URL.Query().Get("watch") == "true" {
+			return "watch"
+		} else if p.name == "" {
+			return "list"
+		}
+		return "get"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if p.name == "" {
+			return "deletecollection"
+		}
+		return "delete"
+	}
+	return strings.ToLower(request.Method)
+}
+
+// parseRequestPath parses the given API path
+// Paths are in the form /api/{version}/... or /apis/{group}/{version}/..., optionally followed by watch/ and
+// namespaces/{namespace}/, and then {resource}/{name}/{subresource}.
+func parseRequestPath(path string) requestPath {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var index int
+	switch {
+	case len(segments) > 0 && segments[0] == "api":
+		index = 2
+	case len(segments) > 0 && segments[0] == "apis":
+		index = 3
+	default:
+		return requestPath{}
+	}
+
+	var p requestPath
+	if len(segments) > index && segments[index] == "watch" {
+		p.watch = true
+		index++
+	}
+	if len(segments) > index+1 && segments[index] == "namespaces" {
+		p.namespace = segments[index+1]
+		if len(segments) == index+2 {
+			// The request targets the namespace itself
+			p.resource = "namespaces"
+			p.name = p.namespace
+			return p
+		}
+		index += 2
+	}
+	if len(segments) > index {
+		p.resource = segments[index]
+	}
+	if len(segments) > index+1 {
+		p.name = segments[index+1]
+	}
+	if len(segments) > index+2 {
+		p.subresource = segments[index+2]
+	}
+	return p
+}
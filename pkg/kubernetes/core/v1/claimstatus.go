@@ -0,0 +1,96 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onosproject/helmit/pkg/kubernetes/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// maxClaimEvents is the maximum number of recent warning events included in a claim's status
+const maxClaimEvents = 5
+
+// PersistentVolumeClaimStatus is the binding status of a PersistentVolumeClaim
+type PersistentVolumeClaimStatus struct {
+	// Phase is the phase of the claim, e.g. Pending or Bound
+	Phase corev1.PersistentVolumeClaimPhase
+	// Volume is the name of the PersistentVolume to which the claim is bound
+	Volume string
+	// StorageClass is the name of the claim's StorageClass
+	StorageClass string
+	// Warnings are the messages of the most recent warning events for the claim, e.g. provisioning failures
+	Warnings []string
+}
+
+// String returns a summary of the claim's status
+func (s PersistentVolumeClaimStatus) String() string {
+	var b strings.Builder
+	b.WriteString(string(s.Phase))
+	if s.Volume != "" {
+		fmt.Fprintf(&b, " to volume %s", s.Volume)
+	}
+	if s.StorageClass != "" {
+		fmt.Fprintf(&b, " (storage class %s)", s.StorageClass)
+	}
+	if len(s.Warnings) > 0 {
+		fmt.Fprintf(&b, ": %s", strings.Join(s.Warnings, "; "))
+	}
+	return b.String()
+}
+
+// Status returns the current binding status of the PersistentVolumeClaim
+func (c *PersistentVolumeClaim) Status(ctx context.Context) (PersistentVolumeClaimStatus, error) {
+	claim, err := c.Clientset().CoreV1().PersistentVolumeClaims(c.Namespace).Get(ctx, c.Name, metav1.GetOptions{})
+	if err != nil {
+		return PersistentVolumeClaimStatus{}, resource.WrapContextError(ctx, err)
+	}
+
+	status := PersistentVolumeClaimStatus{
+		Phase:  claim.Status.Phase,
+		Volume: claim.Spec.VolumeName,
+	}
+	if claim.Spec.StorageClassName != nil {
+		status.StorageClass = *claim.Spec.StorageClassName
+	}
+
+	// Warning events report why the claim can't be bound, e.g. the provisioner failed to create a volume
+	selector := fields.Set{
+		"involvedObject.kind": PersistentVolumeClaimKind.Kind,
+		"involvedObject.name": c.Name,
+		"type":                corev1.EventTypeWarning,
+	}.AsSelector().String()
+	events, err := c.Clientset().CoreV1().Events(c.Namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return PersistentVolumeClaimStatus{}, resource.WrapContextError(ctx, err)
+	}
+	items := events.Items
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].LastTimestamp.Before(&items[j].LastTimestamp)
+	})
+	if len(items) > maxClaimEvents {
+		items = items[len(items)-maxClaimEvents:]
+	}
+	for _, event := range items {
+		status.Warnings = append(status.Warnings, fmt.Sprintf("%s: %s", event.Reason, event.Message))
+	}
+	return status, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type ConfigMapsReader interface {
 	Get(ctx context.Context, name string) (*ConfigMap, error)
 	List(ctx context.Context) ([]*ConfigMap, error)
+	AllNamespaces() ConfigMapsReader
 }
 
 func NewConfigMapsReader(client resource.Client, filter resource.Filter) ConfigMapsReader {
 	return &configMapsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type configMapsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *configMapsReader) AllNamespaces() ConfigMapsReader {
+	return &configMapsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *configMapsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && ConfigMapKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *configMapsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    ConfigMapKind.Group,
+			Resource: ConfigMapResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", ConfigMapResource.Name, err))
+	}
+	return err
 }
 
 func (c *configMapsReader) Get(ctx context.Context, name string) (*ConfigMap, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	configMap := &corev1.ConfigMap{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *configMapsReader) Get(ctx context.Context, name string) (*ConfigMap, er
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ConfigMapKind.Scoped).
+		NamespaceIfScoped(c.namespace, ConfigMapKind.Scoped).
 		Resource(ConfigMapResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(configMap)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   ConfigMapKind.Group,
@@ -75,14 +106,14 @@ func (c *configMapsReader) List(ctx context.Context) ([]*ConfigMap, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ConfigMapKind.Scoped).
+		NamespaceIfScoped(c.namespace, ConfigMapKind.Scoped).
 		Resource(ConfigMapResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*ConfigMap, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *configMapsReader) List(ctx context.Context) ([]*ConfigMap, error) {
 	}
 	return results, nil
 }
+
+func (c *configMapsReader) getFromAllNamespaces(ctx context.Context, name string) (*ConfigMap, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *ConfigMap
+	for _, configMap := range list {
+		if configMap.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    ConfigMapKind.Group,
+					Resource: ConfigMapResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, configMap.Namespace))
+			}
+			result = configMap
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ConfigMapKind.Group,
+			Resource: ConfigMapResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type EndpointsReader interface {
 	Get(ctx context.Context, name string) (*Endpoints, error)
 	List(ctx context.Context) ([]*Endpoints, error)
+	AllNamespaces() EndpointsReader
 }
 
 func NewEndpointsReader(client resource.Client, filter resource.Filter) EndpointsReader {
 	return &endpointsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type endpointsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *endpointsReader) AllNamespaces() EndpointsReader {
+	return &endpointsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *endpointsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && EndpointsKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *endpointsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    EndpointsKind.Group,
+			Resource: EndpointsResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", EndpointsResource.Name, err))
+	}
+	return err
 }
 
 func (c *endpointsReader) Get(ctx context.Context, name string) (*Endpoints, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	endpoints := &corev1.Endpoints{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *endpointsReader) Get(ctx context.Context, name string) (*Endpoints, err
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), EndpointsKind.Scoped).
+		NamespaceIfScoped(c.namespace, EndpointsKind.Scoped).
 		Resource(EndpointsResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(endpoints)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   EndpointsKind.Group,
@@ -75,14 +106,14 @@ func (c *endpointsReader) List(ctx context.Context) ([]*Endpoints, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), EndpointsKind.Scoped).
+		NamespaceIfScoped(c.namespace, EndpointsKind.Scoped).
 		Resource(EndpointsResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Endpoints, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *endpointsReader) List(ctx context.Context) ([]*Endpoints, error) {
 	}
 	return results, nil
 }
+
+func (c *endpointsReader) getFromAllNamespaces(ctx context.Context, name string) (*Endpoints, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Endpoints
+	for _, endpoints := range list {
+		if endpoints.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    EndpointsKind.Group,
+					Resource: EndpointsResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, endpoints.Namespace))
+			}
+			result = endpoints
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    EndpointsKind.Group,
+			Resource: EndpointsResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type NamespacesReader interface {
 	Get(ctx context.Context, name string) (*Namespace, error)
 	List(ctx context.Context) ([]*Namespace, error)
+	AllNamespaces() NamespacesReader
 }
 
 func NewNamespacesReader(client resource.Client, filter resource.Filter) NamespacesReader {
 	return &namespacesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type namespacesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *namespacesReader) AllNamespaces() NamespacesReader {
+	return &namespacesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *namespacesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && NamespaceKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *namespacesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    NamespaceKind.Group,
+			Resource: NamespaceResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", NamespaceResource.Name, err))
+	}
+	return err
 }
 
 func (c *namespacesReader) Get(ctx context.Context, name string) (*Namespace, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	namespace := &corev1.Namespace{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *namespacesReader) Get(ctx context.Context, name string) (*Namespace, er
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), NamespaceKind.Scoped).
+		NamespaceIfScoped(c.namespace, NamespaceKind.Scoped).
 		Resource(NamespaceResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(namespace)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   NamespaceKind.Group,
@@ -75,14 +106,14 @@ func (c *namespacesReader) List(ctx context.Context) ([]*Namespace, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), NamespaceKind.Scoped).
+		NamespaceIfScoped(c.namespace, NamespaceKind.Scoped).
 		Resource(NamespaceResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Namespace, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *namespacesReader) List(ctx context.Context) ([]*Namespace, error) {
 	}
 	return results, nil
 }
+
+func (c *namespacesReader) getFromAllNamespaces(ctx context.Context, name string) (*Namespace, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Namespace
+	for _, namespace := range list {
+		if namespace.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    NamespaceKind.Group,
+					Resource: NamespaceResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, namespace.Namespace))
+			}
+			result = namespace
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    NamespaceKind.Group,
+			Resource: NamespaceResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type NodesReader interface {
 	Get(ctx context.Context, name string) (*Node, error)
 	List(ctx context.Context) ([]*Node, error)
+	AllNamespaces() NodesReader
 }
 
 func NewNodesReader(client resource.Client, filter resource.Filter) NodesReader {
 	return &nodesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type nodesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *nodesReader) AllNamespaces() NodesReader {
+	return &nodesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *nodesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && NodeKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *nodesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    NodeKind.Group,
+			Resource: NodeResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", NodeResource.Name, err))
+	}
+	return err
 }
 
 func (c *nodesReader) Get(ctx context.Context, name string) (*Node, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	node := &corev1.Node{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *nodesReader) Get(ctx context.Context, name string) (*Node, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), NodeKind.Scoped).
+		NamespaceIfScoped(c.namespace, NodeKind.Scoped).
 		Resource(NodeResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(node)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   NodeKind.Group,
@@ -75,14 +106,14 @@ func (c *nodesReader) List(ctx context.Context) ([]*Node, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), NodeKind.Scoped).
+		NamespaceIfScoped(c.namespace, NodeKind.Scoped).
 		Resource(NodeResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Node, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *nodesReader) List(ctx context.Context) ([]*Node, error) {
 	}
 	return results, nil
 }
+
+func (c *nodesReader) getFromAllNamespaces(ctx context.Context, name string) (*Node, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Node
+	for _, node := range list {
+		if node.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    NodeKind.Group,
+					Resource: NodeResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, node.Namespace))
+			}
+			result = node
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    NodeKind.Group,
+			Resource: NodeResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -8,7 +8,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var PersistentVolumeKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *PersistentVolume) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.CoreV1().
+	err = client.CoreV1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, PersistentVolumeKind.Scoped).
 		Resource(PersistentVolumeResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type PersistentVolumeClaimsReader interface {
 	Get(ctx context.Context, name string) (*PersistentVolumeClaim, error)
 	List(ctx context.Context) ([]*PersistentVolumeClaim, error)
+	AllNamespaces() PersistentVolumeClaimsReader
 }
 
 func NewPersistentVolumeClaimsReader(client resource.Client, filter resource.Filter) PersistentVolumeClaimsReader {
 	return &persistentVolumeClaimsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type persistentVolumeClaimsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *persistentVolumeClaimsReader) AllNamespaces() PersistentVolumeClaimsReader {
+	return &persistentVolumeClaimsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *persistentVolumeClaimsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && PersistentVolumeClaimKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *persistentVolumeClaimsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    PersistentVolumeClaimKind.Group,
+			Resource: PersistentVolumeClaimResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", PersistentVolumeClaimResource.Name, err))
+	}
+	return err
 }
 
 func (c *persistentVolumeClaimsReader) Get(ctx context.Context, name string) (*PersistentVolumeClaim, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	persistentVolumeClaim := &corev1.PersistentVolumeClaim{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *persistentVolumeClaimsReader) Get(ctx context.Context, name string) (*P
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PersistentVolumeClaimKind.Scoped).
+		NamespaceIfScoped(c.namespace, PersistentVolumeClaimKind.Scoped).
 		Resource(PersistentVolumeClaimResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(persistentVolumeClaim)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   PersistentVolumeClaimKind.Group,
@@ -75,14 +106,14 @@ func (c *persistentVolumeClaimsReader) List(ctx context.Context) ([]*PersistentV
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PersistentVolumeClaimKind.Scoped).
+		NamespaceIfScoped(c.namespace, PersistentVolumeClaimKind.Scoped).
 		Resource(PersistentVolumeClaimResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*PersistentVolumeClaim, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *persistentVolumeClaimsReader) List(ctx context.Context) ([]*PersistentV
 	}
 	return results, nil
 }
+
+func (c *persistentVolumeClaimsReader) getFromAllNamespaces(ctx context.Context, name string) (*PersistentVolumeClaim, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *PersistentVolumeClaim
+	for _, persistentVolumeClaim := range list {
+		if persistentVolumeClaim.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    PersistentVolumeClaimKind.Group,
+					Resource: PersistentVolumeClaimResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, persistentVolumeClaim.Namespace))
+			}
+			result = persistentVolumeClaim
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    PersistentVolumeClaimKind.Group,
+			Resource: PersistentVolumeClaimResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type PersistentVolumesReader interface {
 	Get(ctx context.Context, name string) (*PersistentVolume, error)
 	List(ctx context.Context) ([]*PersistentVolume, error)
+	AllNamespaces() PersistentVolumesReader
 }
 
 func NewPersistentVolumesReader(client resource.Client, filter resource.Filter) PersistentVolumesReader {
 	return &persistentVolumesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type persistentVolumesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *persistentVolumesReader) AllNamespaces() PersistentVolumesReader {
+	return &persistentVolumesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *persistentVolumesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && PersistentVolumeKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *persistentVolumesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    PersistentVolumeKind.Group,
+			Resource: PersistentVolumeResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", PersistentVolumeResource.Name, err))
+	}
+	return err
 }
 
 func (c *persistentVolumesReader) Get(ctx context.Context, name string) (*PersistentVolume, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	persistentVolume := &corev1.PersistentVolume{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *persistentVolumesReader) Get(ctx context.Context, name string) (*Persis
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PersistentVolumeKind.Scoped).
+		NamespaceIfScoped(c.namespace, PersistentVolumeKind.Scoped).
 		Resource(PersistentVolumeResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(persistentVolume)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   PersistentVolumeKind.Group,
@@ -75,14 +106,14 @@ func (c *persistentVolumesReader) List(ctx context.Context) ([]*PersistentVolume
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PersistentVolumeKind.Scoped).
+		NamespaceIfScoped(c.namespace, PersistentVolumeKind.Scoped).
 		Resource(PersistentVolumeResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*PersistentVolume, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *persistentVolumesReader) List(ctx context.Context) ([]*PersistentVolume
 	}
 	return results, nil
 }
+
+func (c *persistentVolumesReader) getFromAllNamespaces(ctx context.Context, name string) (*PersistentVolume, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *PersistentVolume
+	for _, persistentVolume := range list {
+		if persistentVolume.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    PersistentVolumeKind.Group,
+					Resource: PersistentVolumeResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, persistentVolume.Namespace))
+			}
+			result = persistentVolume
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    PersistentVolumeKind.Group,
+			Resource: PersistentVolumeResource.Name,
+		}, name)
+	}
+	return result, nil
+}
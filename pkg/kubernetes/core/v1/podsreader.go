@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type PodsReader interface {
 	Get(ctx context.Context, name string) (*Pod, error)
 	List(ctx context.Context) ([]*Pod, error)
+	AllNamespaces() PodsReader
 }
 
 func NewPodsReader(client resource.Client, filter resource.Filter) PodsReader {
 	return &podsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type podsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *podsReader) AllNamespaces() PodsReader {
+	return &podsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *podsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && PodKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *podsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    PodKind.Group,
+			Resource: PodResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", PodResource.Name, err))
+	}
+	return err
 }
 
 func (c *podsReader) Get(ctx context.Context, name string) (*Pod, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	pod := &corev1.Pod{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *podsReader) Get(ctx context.Context, name string) (*Pod, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodKind.Scoped).
 		Resource(PodResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(pod)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   PodKind.Group,
@@ -75,14 +106,14 @@ func (c *podsReader) List(ctx context.Context) ([]*Pod, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodKind.Scoped).
 		Resource(PodResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Pod, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *podsReader) List(ctx context.Context) ([]*Pod, error) {
 	}
 	return results, nil
 }
+
+func (c *podsReader) getFromAllNamespaces(ctx context.Context, name string) (*Pod, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Pod
+	for _, pod := range list {
+		if pod.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    PodKind.Group,
+					Resource: PodResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, pod.Namespace))
+			}
+			result = pod
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    PodKind.Group,
+			Resource: PodResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type PodTemplatesReader interface {
 	Get(ctx context.Context, name string) (*PodTemplate, error)
 	List(ctx context.Context) ([]*PodTemplate, error)
+	AllNamespaces() PodTemplatesReader
 }
 
 func NewPodTemplatesReader(client resource.Client, filter resource.Filter) PodTemplatesReader {
 	return &podTemplatesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type podTemplatesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *podTemplatesReader) AllNamespaces() PodTemplatesReader {
+	return &podTemplatesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *podTemplatesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && PodTemplateKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *podTemplatesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    PodTemplateKind.Group,
+			Resource: PodTemplateResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", PodTemplateResource.Name, err))
+	}
+	return err
 }
 
 func (c *podTemplatesReader) Get(ctx context.Context, name string) (*PodTemplate, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	podTemplate := &corev1.PodTemplate{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *podTemplatesReader) Get(ctx context.Context, name string) (*PodTemplate
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodTemplateKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodTemplateKind.Scoped).
 		Resource(PodTemplateResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(podTemplate)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   PodTemplateKind.Group,
@@ -75,14 +106,14 @@ func (c *podTemplatesReader) List(ctx context.Context) ([]*PodTemplate, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodTemplateKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodTemplateKind.Scoped).
 		Resource(PodTemplateResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*PodTemplate, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *podTemplatesReader) List(ctx context.Context) ([]*PodTemplate, error) {
 	}
 	return results, nil
 }
+
+func (c *podTemplatesReader) getFromAllNamespaces(ctx context.Context, name string) (*PodTemplate, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *PodTemplate
+	for _, podTemplate := range list {
+		if podTemplate.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    PodTemplateKind.Group,
+					Resource: PodTemplateResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, podTemplate.Namespace))
+			}
+			result = podTemplate
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    PodTemplateKind.Group,
+			Resource: PodTemplateResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type SecretsReader interface {
 	Get(ctx context.Context, name string) (*Secret, error)
 	List(ctx context.Context) ([]*Secret, error)
+	AllNamespaces() SecretsReader
 }
 
 func NewSecretsReader(client resource.Client, filter resource.Filter) SecretsReader {
 	return &secretsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type secretsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *secretsReader) AllNamespaces() SecretsReader {
+	return &secretsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *secretsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && SecretKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *secretsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    SecretKind.Group,
+			Resource: SecretResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", SecretResource.Name, err))
+	}
+	return err
 }
 
 func (c *secretsReader) Get(ctx context.Context, name string) (*Secret, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	secret := &corev1.Secret{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *secretsReader) Get(ctx context.Context, name string) (*Secret, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), SecretKind.Scoped).
+		NamespaceIfScoped(c.namespace, SecretKind.Scoped).
 		Resource(SecretResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(secret)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   SecretKind.Group,
@@ -75,14 +106,14 @@ func (c *secretsReader) List(ctx context.Context) ([]*Secret, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), SecretKind.Scoped).
+		NamespaceIfScoped(c.namespace, SecretKind.Scoped).
 		Resource(SecretResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Secret, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *secretsReader) List(ctx context.Context) ([]*Secret, error) {
 	}
 	return results, nil
 }
+
+func (c *secretsReader) getFromAllNamespaces(ctx context.Context, name string) (*Secret, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Secret
+	for _, secret := range list {
+		if secret.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    SecretKind.Group,
+					Resource: SecretResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, secret.Namespace))
+			}
+			result = secret
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    SecretKind.Group,
+			Resource: SecretResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type ServicesReader interface {
 	Get(ctx context.Context, name string) (*Service, error)
 	List(ctx context.Context) ([]*Service, error)
+	AllNamespaces() ServicesReader
 }
 
 func NewServicesReader(client resource.Client, filter resource.Filter) ServicesReader {
 	return &servicesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type servicesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *servicesReader) AllNamespaces() ServicesReader {
+	return &servicesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *servicesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && ServiceKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *servicesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    ServiceKind.Group,
+			Resource: ServiceResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", ServiceResource.Name, err))
+	}
+	return err
 }
 
 func (c *servicesReader) Get(ctx context.Context, name string) (*Service, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	service := &corev1.Service{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *servicesReader) Get(ctx context.Context, name string) (*Service, error)
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ServiceKind.Scoped).
+		NamespaceIfScoped(c.namespace, ServiceKind.Scoped).
 		Resource(ServiceResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(service)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   ServiceKind.Group,
@@ -75,14 +106,14 @@ func (c *servicesReader) List(ctx context.Context) ([]*Service, error) {
 	err = client.CoreV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ServiceKind.Scoped).
+		NamespaceIfScoped(c.namespace, ServiceKind.Scoped).
 		Resource(ServiceResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Service, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *servicesReader) List(ctx context.Context) ([]*Service, error) {
 	}
 	return results, nil
 }
+
+func (c *servicesReader) getFromAllNamespaces(ctx context.Context, name string) (*Service, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Service
+	for _, service := range list {
+		if service.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    ServiceKind.Group,
+					Resource: ServiceResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, service.Namespace))
+			}
+			result = service
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ServiceKind.Group,
+			Resource: ServiceResource.Name,
+		}, name)
+	}
+	return result, nil
+}
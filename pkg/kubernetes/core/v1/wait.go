@@ -16,6 +16,7 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -38,6 +39,29 @@ func (p *Pod) Wait(ctx context.Context, timeout time.Duration) error {
 	})
 }
 
+// WaitForContainerReady waits for the named container in the Pod to be ready
+// Unlike Wait, the readiness of other containers in the Pod is ignored, allowing tests to proceed
+// once the relevant container is up even if a sidecar is slow to become ready.
+func (p *Pod) WaitForContainerReady(ctx context.Context, container string, timeout time.Duration) error {
+	return wait.Poll(time.Second, timeout, func() (bool, error) {
+		pod, err := p.Clientset().CoreV1().Pods(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == container {
+				return status.Ready, nil
+			}
+		}
+		for _, c := range pod.Spec.Containers {
+			if c.Name == container {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("container %s not found in pod %s", container, p.Name)
+	})
+}
+
 // Wait waits for the Service to be ready
 func (s *Service) Wait(ctx context.Context, timeout time.Duration) error {
 	return wait.Poll(time.Second, timeout, func() (bool, error) {
@@ -62,3 +86,22 @@ func (s *Service) Wait(ctx context.Context, timeout time.Duration) error {
 		return true, nil
 	})
 }
+
+// WaitForBound waits for the PersistentVolumeClaim to be bound to a volume
+// If the claim is not bound before the timeout, the returned error includes the claim's status and
+// recent warning events, e.g. a provisioning failure reported for the claim's StorageClass.
+func (c *PersistentVolumeClaim) WaitForBound(ctx context.Context, timeout time.Duration) error {
+	err := wait.Poll(time.Second, timeout, func() (bool, error) {
+		claim, err := c.Clientset().CoreV1().PersistentVolumeClaims(c.Namespace).Get(ctx, c.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return claim.Status.Phase == corev1.ClaimBound, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		if status, statusErr := c.Status(ctx); statusErr == nil {
+			return fmt.Errorf("persistent volume claim %s not bound: %s", c.Name, status)
+		}
+	}
+	return err
+}
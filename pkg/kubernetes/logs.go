@@ -0,0 +1,101 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WaitForLogPattern waits until the logs of every container in the client's pods match the given pattern
+// Pods are discovered once when the method is called, and the logs of each container are tailed concurrently.
+// If the timeout expires before all containers match, the returned error lists the containers that did not match.
+func (c *client) WaitForLogPattern(ctx context.Context, pattern string, timeout time.Duration) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pods, err := c.CoreV1().Pods().List(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("no pods found")
+	}
+
+	var unmatched []string
+	mu := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, pod := range pods {
+		for _, container := range pod.Object.Spec.Containers {
+			wg.Add(1)
+			go func(pod *corev1.Pod, container string) {
+				defer wg.Done()
+				if !c.matchLogs(ctx, pod, container, re) {
+					mu.Lock()
+					unmatched = append(unmatched, fmt.Sprintf("%s/%s", pod.Name, container))
+					mu.Unlock()
+				}
+			}(pod.Object, container.Name)
+		}
+	}
+	wg.Wait()
+
+	if len(unmatched) > 0 {
+		sort.Strings(unmatched)
+		return fmt.Errorf("timed out waiting for logs matching %q in %s", pattern, strings.Join(unmatched, ", "))
+	}
+	return nil
+}
+
+// matchLogs tails the logs of the given container until a line matches the pattern or the context is done
+func (c *client) matchLogs(ctx context.Context, pod *corev1.Pod, container string, re *regexp.Regexp) bool {
+	for {
+		req := c.Clientset().CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container: container,
+			Follow:    true,
+		})
+		reader, err := req.Stream(ctx)
+		if err == nil {
+			scanner := bufio.NewScanner(reader)
+			for scanner.Scan() {
+				if re.MatchString(scanner.Text()) {
+					reader.Close()
+					return true
+				}
+			}
+			reader.Close()
+		}
+
+		// Retry if the container has not started or the log stream was closed before the pattern was found
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(time.Second):
+		}
+	}
+}
@@ -8,7 +8,6 @@ import (
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var IngressKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *Ingress) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.NetworkingV1beta1().
+	err = client.NetworkingV1beta1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, IngressKind.Scoped).
 		Resource(IngressResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
@@ -4,33 +4,64 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type IngressesReader interface {
 	Get(ctx context.Context, name string) (*Ingress, error)
 	List(ctx context.Context) ([]*Ingress, error)
+	AllNamespaces() IngressesReader
 }
 
 func NewIngressesReader(client resource.Client, filter resource.Filter) IngressesReader {
 	return &ingressesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type ingressesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *ingressesReader) AllNamespaces() IngressesReader {
+	return &ingressesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *ingressesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && IngressKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *ingressesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    IngressKind.Group,
+			Resource: IngressResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", IngressResource.Name, err))
+	}
+	return err
 }
 
 func (c *ingressesReader) Get(ctx context.Context, name string) (*Ingress, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	ingress := &networkingv1beta1.Ingress{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *ingressesReader) Get(ctx context.Context, name string) (*Ingress, error
 	err = client.NetworkingV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), IngressKind.Scoped).
+		NamespaceIfScoped(c.namespace, IngressKind.Scoped).
 		Resource(IngressResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(ingress)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   IngressKind.Group,
@@ -75,14 +106,14 @@ func (c *ingressesReader) List(ctx context.Context) ([]*Ingress, error) {
 	err = client.NetworkingV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), IngressKind.Scoped).
+		NamespaceIfScoped(c.namespace, IngressKind.Scoped).
 		Resource(IngressResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Ingress, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *ingressesReader) List(ctx context.Context) ([]*Ingress, error) {
 	}
 	return results, nil
 }
+
+func (c *ingressesReader) getFromAllNamespaces(ctx context.Context, name string) (*Ingress, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Ingress
+	for _, ingress := range list {
+		if ingress.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    IngressKind.Group,
+					Resource: IngressResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, ingress.Namespace))
+			}
+			result = ingress
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    IngressKind.Group,
+			Resource: IngressResource.Name,
+		}, name)
+	}
+	return result, nil
+}
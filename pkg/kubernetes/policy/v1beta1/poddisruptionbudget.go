@@ -8,7 +8,6 @@ import (
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var PodDisruptionBudgetKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *PodDisruptionBudget) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.PolicyV1beta1().
+	err = client.PolicyV1beta1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, PodDisruptionBudgetKind.Scoped).
 		Resource(PodDisruptionBudgetResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
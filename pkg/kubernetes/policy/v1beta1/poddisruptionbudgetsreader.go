@@ -4,33 +4,64 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type PodDisruptionBudgetsReader interface {
 	Get(ctx context.Context, name string) (*PodDisruptionBudget, error)
 	List(ctx context.Context) ([]*PodDisruptionBudget, error)
+	AllNamespaces() PodDisruptionBudgetsReader
 }
 
 func NewPodDisruptionBudgetsReader(client resource.Client, filter resource.Filter) PodDisruptionBudgetsReader {
 	return &podDisruptionBudgetsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type podDisruptionBudgetsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *podDisruptionBudgetsReader) AllNamespaces() PodDisruptionBudgetsReader {
+	return &podDisruptionBudgetsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *podDisruptionBudgetsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && PodDisruptionBudgetKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *podDisruptionBudgetsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    PodDisruptionBudgetKind.Group,
+			Resource: PodDisruptionBudgetResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", PodDisruptionBudgetResource.Name, err))
+	}
+	return err
 }
 
 func (c *podDisruptionBudgetsReader) Get(ctx context.Context, name string) (*PodDisruptionBudget, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	podDisruptionBudget := &policyv1beta1.PodDisruptionBudget{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *podDisruptionBudgetsReader) Get(ctx context.Context, name string) (*Pod
 	err = client.PolicyV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodDisruptionBudgetKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodDisruptionBudgetKind.Scoped).
 		Resource(PodDisruptionBudgetResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(podDisruptionBudget)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   PodDisruptionBudgetKind.Group,
@@ -75,14 +106,14 @@ func (c *podDisruptionBudgetsReader) List(ctx context.Context) ([]*PodDisruption
 	err = client.PolicyV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodDisruptionBudgetKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodDisruptionBudgetKind.Scoped).
 		Resource(PodDisruptionBudgetResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*PodDisruptionBudget, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *podDisruptionBudgetsReader) List(ctx context.Context) ([]*PodDisruption
 	}
 	return results, nil
 }
+
+func (c *podDisruptionBudgetsReader) getFromAllNamespaces(ctx context.Context, name string) (*PodDisruptionBudget, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *PodDisruptionBudget
+	for _, podDisruptionBudget := range list {
+		if podDisruptionBudget.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    PodDisruptionBudgetKind.Group,
+					Resource: PodDisruptionBudgetResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, podDisruptionBudget.Namespace))
+			}
+			result = podDisruptionBudget
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    PodDisruptionBudgetKind.Group,
+			Resource: PodDisruptionBudgetResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type PodSecurityPoliciesReader interface {
 	Get(ctx context.Context, name string) (*PodSecurityPolicy, error)
 	List(ctx context.Context) ([]*PodSecurityPolicy, error)
+	AllNamespaces() PodSecurityPoliciesReader
 }
 
 func NewPodSecurityPoliciesReader(client resource.Client, filter resource.Filter) PodSecurityPoliciesReader {
 	return &podSecurityPoliciesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type podSecurityPoliciesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *podSecurityPoliciesReader) AllNamespaces() PodSecurityPoliciesReader {
+	return &podSecurityPoliciesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *podSecurityPoliciesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && PodSecurityPolicyKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *podSecurityPoliciesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    PodSecurityPolicyKind.Group,
+			Resource: PodSecurityPolicyResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", PodSecurityPolicyResource.Name, err))
+	}
+	return err
 }
 
 func (c *podSecurityPoliciesReader) Get(ctx context.Context, name string) (*PodSecurityPolicy, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	podSecurityPolicy := &policyv1beta1.PodSecurityPolicy{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *podSecurityPoliciesReader) Get(ctx context.Context, name string) (*PodS
 	err = client.PolicyV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodSecurityPolicyKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodSecurityPolicyKind.Scoped).
 		Resource(PodSecurityPolicyResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(podSecurityPolicy)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   PodSecurityPolicyKind.Group,
@@ -75,14 +106,14 @@ func (c *podSecurityPoliciesReader) List(ctx context.Context) ([]*PodSecurityPol
 	err = client.PolicyV1beta1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), PodSecurityPolicyKind.Scoped).
+		NamespaceIfScoped(c.namespace, PodSecurityPolicyKind.Scoped).
 		Resource(PodSecurityPolicyResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*PodSecurityPolicy, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *podSecurityPoliciesReader) List(ctx context.Context) ([]*PodSecurityPol
 	}
 	return results, nil
 }
+
+func (c *podSecurityPoliciesReader) getFromAllNamespaces(ctx context.Context, name string) (*PodSecurityPolicy, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *PodSecurityPolicy
+	for _, podSecurityPolicy := range list {
+		if podSecurityPolicy.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    PodSecurityPolicyKind.Group,
+					Resource: PodSecurityPolicyResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, podSecurityPolicy.Namespace))
+			}
+			result = podSecurityPolicy
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    PodSecurityPolicyKind.Group,
+			Resource: PodSecurityPolicyResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -8,7 +8,6 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var ClusterRoleKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *ClusterRole) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.RbacV1().
+	err = client.RbacV1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, ClusterRoleKind.Scoped).
 		Resource(ClusterRoleResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
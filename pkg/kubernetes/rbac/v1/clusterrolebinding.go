@@ -8,7 +8,6 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var ClusterRoleBindingKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *ClusterRoleBinding) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.RbacV1().
+	err = client.RbacV1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, ClusterRoleBindingKind.Scoped).
 		Resource(ClusterRoleBindingResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type ClusterRoleBindingsReader interface {
 	Get(ctx context.Context, name string) (*ClusterRoleBinding, error)
 	List(ctx context.Context) ([]*ClusterRoleBinding, error)
+	AllNamespaces() ClusterRoleBindingsReader
 }
 
 func NewClusterRoleBindingsReader(client resource.Client, filter resource.Filter) ClusterRoleBindingsReader {
 	return &clusterRoleBindingsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type clusterRoleBindingsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *clusterRoleBindingsReader) AllNamespaces() ClusterRoleBindingsReader {
+	return &clusterRoleBindingsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *clusterRoleBindingsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && ClusterRoleBindingKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *clusterRoleBindingsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    ClusterRoleBindingKind.Group,
+			Resource: ClusterRoleBindingResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", ClusterRoleBindingResource.Name, err))
+	}
+	return err
 }
 
 func (c *clusterRoleBindingsReader) Get(ctx context.Context, name string) (*ClusterRoleBinding, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	clusterRoleBinding := &rbacv1.ClusterRoleBinding{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *clusterRoleBindingsReader) Get(ctx context.Context, name string) (*Clus
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ClusterRoleBindingKind.Scoped).
+		NamespaceIfScoped(c.namespace, ClusterRoleBindingKind.Scoped).
 		Resource(ClusterRoleBindingResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(clusterRoleBinding)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   ClusterRoleBindingKind.Group,
@@ -75,14 +106,14 @@ func (c *clusterRoleBindingsReader) List(ctx context.Context) ([]*ClusterRoleBin
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ClusterRoleBindingKind.Scoped).
+		NamespaceIfScoped(c.namespace, ClusterRoleBindingKind.Scoped).
 		Resource(ClusterRoleBindingResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*ClusterRoleBinding, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *clusterRoleBindingsReader) List(ctx context.Context) ([]*ClusterRoleBin
 	}
 	return results, nil
 }
+
+func (c *clusterRoleBindingsReader) getFromAllNamespaces(ctx context.Context, name string) (*ClusterRoleBinding, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *ClusterRoleBinding
+	for _, clusterRoleBinding := range list {
+		if clusterRoleBinding.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    ClusterRoleBindingKind.Group,
+					Resource: ClusterRoleBindingResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, clusterRoleBinding.Namespace))
+			}
+			result = clusterRoleBinding
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterRoleBindingKind.Group,
+			Resource: ClusterRoleBindingResource.Name,
+		}, name)
+	}
+	return result, nil
+}
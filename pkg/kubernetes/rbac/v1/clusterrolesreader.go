@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type ClusterRolesReader interface {
 	Get(ctx context.Context, name string) (*ClusterRole, error)
 	List(ctx context.Context) ([]*ClusterRole, error)
+	AllNamespaces() ClusterRolesReader
 }
 
 func NewClusterRolesReader(client resource.Client, filter resource.Filter) ClusterRolesReader {
 	return &clusterRolesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type clusterRolesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *clusterRolesReader) AllNamespaces() ClusterRolesReader {
+	return &clusterRolesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *clusterRolesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && ClusterRoleKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *clusterRolesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    ClusterRoleKind.Group,
+			Resource: ClusterRoleResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", ClusterRoleResource.Name, err))
+	}
+	return err
 }
 
 func (c *clusterRolesReader) Get(ctx context.Context, name string) (*ClusterRole, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	clusterRole := &rbacv1.ClusterRole{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *clusterRolesReader) Get(ctx context.Context, name string) (*ClusterRole
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ClusterRoleKind.Scoped).
+		NamespaceIfScoped(c.namespace, ClusterRoleKind.Scoped).
 		Resource(ClusterRoleResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(clusterRole)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   ClusterRoleKind.Group,
@@ -75,14 +106,14 @@ func (c *clusterRolesReader) List(ctx context.Context) ([]*ClusterRole, error) {
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), ClusterRoleKind.Scoped).
+		NamespaceIfScoped(c.namespace, ClusterRoleKind.Scoped).
 		Resource(ClusterRoleResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*ClusterRole, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *clusterRolesReader) List(ctx context.Context) ([]*ClusterRole, error) {
 	}
 	return results, nil
 }
+
+func (c *clusterRolesReader) getFromAllNamespaces(ctx context.Context, name string) (*ClusterRole, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *ClusterRole
+	for _, clusterRole := range list {
+		if clusterRole.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    ClusterRoleKind.Group,
+					Resource: ClusterRoleResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, clusterRole.Namespace))
+			}
+			result = clusterRole
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterRoleKind.Group,
+			Resource: ClusterRoleResource.Name,
+		}, name)
+	}
+	return result, nil
+}
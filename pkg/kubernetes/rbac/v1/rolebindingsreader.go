@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type RoleBindingsReader interface {
 	Get(ctx context.Context, name string) (*RoleBinding, error)
 	List(ctx context.Context) ([]*RoleBinding, error)
+	AllNamespaces() RoleBindingsReader
 }
 
 func NewRoleBindingsReader(client resource.Client, filter resource.Filter) RoleBindingsReader {
 	return &roleBindingsReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type roleBindingsReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *roleBindingsReader) AllNamespaces() RoleBindingsReader {
+	return &roleBindingsReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *roleBindingsReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && RoleBindingKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *roleBindingsReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    RoleBindingKind.Group,
+			Resource: RoleBindingResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", RoleBindingResource.Name, err))
+	}
+	return err
 }
 
 func (c *roleBindingsReader) Get(ctx context.Context, name string) (*RoleBinding, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	roleBinding := &rbacv1.RoleBinding{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *roleBindingsReader) Get(ctx context.Context, name string) (*RoleBinding
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), RoleBindingKind.Scoped).
+		NamespaceIfScoped(c.namespace, RoleBindingKind.Scoped).
 		Resource(RoleBindingResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(roleBinding)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   RoleBindingKind.Group,
@@ -75,14 +106,14 @@ func (c *roleBindingsReader) List(ctx context.Context) ([]*RoleBinding, error) {
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), RoleBindingKind.Scoped).
+		NamespaceIfScoped(c.namespace, RoleBindingKind.Scoped).
 		Resource(RoleBindingResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*RoleBinding, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *roleBindingsReader) List(ctx context.Context) ([]*RoleBinding, error) {
 	}
 	return results, nil
 }
+
+func (c *roleBindingsReader) getFromAllNamespaces(ctx context.Context, name string) (*RoleBinding, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *RoleBinding
+	for _, roleBinding := range list {
+		if roleBinding.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    RoleBindingKind.Group,
+					Resource: RoleBindingResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, roleBinding.Namespace))
+			}
+			result = roleBinding
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    RoleBindingKind.Group,
+			Resource: RoleBindingResource.Name,
+		}, name)
+	}
+	return result, nil
+}
@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type RolesReader interface {
 	Get(ctx context.Context, name string) (*Role, error)
 	List(ctx context.Context) ([]*Role, error)
+	AllNamespaces() RolesReader
 }
 
 func NewRolesReader(client resource.Client, filter resource.Filter) RolesReader {
 	return &rolesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type rolesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *rolesReader) AllNamespaces() RolesReader {
+	return &rolesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *rolesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && RoleKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *rolesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    RoleKind.Group,
+			Resource: RoleResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", RoleResource.Name, err))
+	}
+	return err
 }
 
 func (c *rolesReader) Get(ctx context.Context, name string) (*Role, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	role := &rbacv1.Role{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *rolesReader) Get(ctx context.Context, name string) (*Role, error) {
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), RoleKind.Scoped).
+		NamespaceIfScoped(c.namespace, RoleKind.Scoped).
 		Resource(RoleResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(role)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   RoleKind.Group,
@@ -75,14 +106,14 @@ func (c *rolesReader) List(ctx context.Context) ([]*Role, error) {
 	err = client.RbacV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), RoleKind.Scoped).
+		NamespaceIfScoped(c.namespace, RoleKind.Scoped).
 		Resource(RoleResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*Role, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *rolesReader) List(ctx context.Context) ([]*Role, error) {
 	}
 	return results, nil
 }
+
+func (c *rolesReader) getFromAllNamespaces(ctx context.Context, name string) (*Role, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *Role
+	for _, role := range list {
+		if role.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    RoleKind.Group,
+					Resource: RoleResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, role.Namespace))
+			}
+			result = role
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    RoleKind.Group,
+			Resource: RoleResource.Name,
+		}, name)
+	}
+	return result, nil
+}
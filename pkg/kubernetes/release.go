@@ -0,0 +1,50 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onosproject/helmit/pkg/helm"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ReleaseCondition is a condition on the objects of a release
+// The condition is evaluated with a client scoped to the release's objects.
+type ReleaseCondition func(client Client) (bool, error)
+
+// WaitForRelease polls the given condition against the objects of the named release until it returns true
+// The release is looked up in the current Helm namespace. If the context is done before the condition is met,
+// an error wrapping the context's error is returned, and an error returned by the condition stops the wait.
+// If no release with the given name has been declared, a helm.ReleaseNotFound error is returned.
+func WaitForRelease(ctx context.Context, release string, cond ReleaseCondition) error {
+	helmRelease := helm.Release(release)
+	if helmRelease == nil {
+		return &helm.ReleaseNotFound{Release: release}
+	}
+	client, err := NewForRelease(helmRelease)
+	if err != nil {
+		return err
+	}
+	err = wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		return cond(client)
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("release %s: condition not met: %w", release, ctx.Err())
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+// Copyright 2020-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultTimeout is the maximum time to wait for a single resource request
+const DefaultTimeout = time.Minute
+
+// Timeout returns the timeout for a request made with the given context
+// The timeout is the smaller of DefaultTimeout and the time remaining until the context's deadline.
+func Timeout(ctx context.Context) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return DefaultTimeout
+	}
+	remaining := time.Until(deadline)
+	if remaining < DefaultTimeout {
+		return remaining
+	}
+	return DefaultTimeout
+}
+
+// ContextError is returned when a request is aborted because the caller's context was cancelled
+// or its deadline was exceeded
+type ContextError struct {
+	// Err is the context error, either context.Canceled or context.DeadlineExceeded
+	Err error
+	// Cause is the error returned by the aborted request
+	Cause error
+}
+
+func (e *ContextError) Error() string {
+	return fmt.Sprintf("request aborted: %v: %v", e.Err, e.Cause)
+}
+
+// Unwrap returns the context error, so errors.Is(err, context.Canceled) reports cancellation
+func (e *ContextError) Unwrap() error {
+	return e.Err
+}
+
+// IsContextError returns whether the given error was caused by the cancellation or expiration of the caller's context
+func IsContextError(err error) bool {
+	var contextErr *ContextError
+	return errors.As(err, &contextErr)
+}
+
+// WrapContextError returns a ContextError if the given request error occurred after the context was done
+func WrapContextError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	return &ContextError{
+		Err:   ctx.Err(),
+		Cause: err,
+	}
+}
@@ -8,7 +8,6 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 var StorageClassKind = resource.Kind{
@@ -40,14 +39,15 @@ func (r *StorageClass) Delete(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return client.StorageV1().
+	err = client.StorageV1().
 		RESTClient().
 		Delete().
 		NamespaceIfScoped(r.Namespace, StorageClassKind.Scoped).
 		Resource(StorageClassResource.Name).
 		Name(r.Name).
 		VersionedParams(&metav1.DeleteOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Error()
+	return resource.WrapContextError(ctx, err)
 }
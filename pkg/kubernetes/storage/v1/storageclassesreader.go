@@ -4,33 +4,64 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/helmit/pkg/kubernetes/resource"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubernetes "k8s.io/client-go/kubernetes"
-	"time"
 )
 
 type StorageClassesReader interface {
 	Get(ctx context.Context, name string) (*StorageClass, error)
 	List(ctx context.Context) ([]*StorageClass, error)
+	AllNamespaces() StorageClassesReader
 }
 
 func NewStorageClassesReader(client resource.Client, filter resource.Filter) StorageClassesReader {
 	return &storageClassesReader{
-		Client: client,
-		filter: filter,
+		Client:    client,
+		filter:    filter,
+		namespace: client.Namespace(),
 	}
 }
 
 type storageClassesReader struct {
 	resource.Client
-	filter resource.Filter
+	filter    resource.Filter
+	namespace string
+}
+
+// AllNamespaces returns a reader that reads resources across all namespaces
+func (c *storageClassesReader) AllNamespaces() StorageClassesReader {
+	return &storageClassesReader{
+		Client:    c.Client,
+		filter:    c.filter,
+		namespace: metav1.NamespaceAll,
+	}
+}
+
+// allNamespaces returns whether the reader reads resources across all namespaces
+func (c *storageClassesReader) allNamespaces() bool {
+	return c.namespace == metav1.NamespaceAll && StorageClassKind.Scoped
+}
+
+// forbidden returns a descriptive error when the client is not permitted to read resources across all namespaces
+func (c *storageClassesReader) forbidden(err error) error {
+	if c.allNamespaces() && errors.IsForbidden(err) {
+		return errors.NewForbidden(schema.GroupResource{
+			Group:    StorageClassKind.Group,
+			Resource: StorageClassResource.Name,
+		}, "", fmt.Errorf("reading %s across all namespaces requires a ClusterRole granting list access: %v", StorageClassResource.Name, err))
+	}
+	return err
 }
 
 func (c *storageClassesReader) Get(ctx context.Context, name string) (*StorageClass, error) {
+	if c.allNamespaces() {
+		return c.getFromAllNamespaces(ctx, name)
+	}
 	storageClass := &storagev1.StorageClass{}
 	client, err := kubernetes.NewForConfig(c.Config())
 	if err != nil {
@@ -39,15 +70,15 @@ func (c *storageClassesReader) Get(ctx context.Context, name string) (*StorageCl
 	err = client.StorageV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), StorageClassKind.Scoped).
+		NamespaceIfScoped(c.namespace, StorageClassKind.Scoped).
 		Resource(StorageClassResource.Name).
 		Name(name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(storageClass)
 	if err != nil {
-		return nil, err
+		return nil, resource.WrapContextError(ctx, err)
 	} else {
 		ok, err := c.filter(metav1.GroupVersionKind{
 			Group:   StorageClassKind.Group,
@@ -75,14 +106,14 @@ func (c *storageClassesReader) List(ctx context.Context) ([]*StorageClass, error
 	err = client.StorageV1().
 		RESTClient().
 		Get().
-		NamespaceIfScoped(c.Namespace(), StorageClassKind.Scoped).
+		NamespaceIfScoped(c.namespace, StorageClassKind.Scoped).
 		Resource(StorageClassResource.Name).
 		VersionedParams(&metav1.ListOptions{}, metav1.ParameterCodec).
-		Timeout(time.Minute).
+		Timeout(resource.Timeout(ctx)).
 		Do(ctx).
 		Into(list)
 	if err != nil {
-		return nil, err
+		return nil, c.forbidden(resource.WrapContextError(ctx, err))
 	}
 
 	results := make([]*StorageClass, 0, len(list.Items))
@@ -101,3 +132,29 @@ func (c *storageClassesReader) List(ctx context.Context) ([]*StorageClass, error
 	}
 	return results, nil
 }
+
+func (c *storageClassesReader) getFromAllNamespaces(ctx context.Context, name string) (*StorageClass, error) {
+	list, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *StorageClass
+	for _, storageClass := range list {
+		if storageClass.Name == name {
+			if result != nil {
+				return nil, errors.NewConflict(schema.GroupResource{
+					Group:    StorageClassKind.Group,
+					Resource: StorageClassResource.Name,
+				}, name, fmt.Errorf("found in namespaces %s and %s", result.Namespace, storageClass.Namespace))
+			}
+			result = storageClass
+		}
+	}
+	if result == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    StorageClassKind.Group,
+			Resource: StorageClassResource.Name,
+		}, name)
+	}
+	return result, nil
+}
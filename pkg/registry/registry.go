@@ -14,12 +14,21 @@
 
 package registry
 
+import (
+	"fmt"
+	"sort"
+)
+
 var tests = make(map[string]interface{})
 var benchmarks = make(map[string]interface{})
 var simulations = make(map[string]interface{})
 
 // RegisterTestSuite registers a test suite
+// Registering a suite under a name that is already registered panics.
 func RegisterTestSuite(name string, suite interface{}) {
+	if _, ok := tests[name]; ok {
+		panic(fmt.Sprintf("test suite %s is already registered", name))
+	}
 	tests[name] = suite
 }
 
@@ -29,6 +38,7 @@ func GetTestSuites() []string {
 	for name := range tests {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
@@ -38,7 +48,11 @@ func GetTestSuite(name string) interface{} {
 }
 
 // RegisterBenchmarkSuite registers a benchmark suite
+// Registering a suite under a name that is already registered panics.
 func RegisterBenchmarkSuite(name string, suite interface{}) {
+	if _, ok := benchmarks[name]; ok {
+		panic(fmt.Sprintf("benchmark suite %s is already registered", name))
+	}
 	benchmarks[name] = suite
 }
 
@@ -48,6 +62,7 @@ func GetBenchmarkSuites() []string {
 	for name := range benchmarks {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
@@ -57,7 +72,11 @@ func GetBenchmarkSuite(name string) interface{} {
 }
 
 // RegisterSimulationSuite registers a simulation suite
+// Registering a suite under a name that is already registered panics.
 func RegisterSimulationSuite(name string, suite interface{}) {
+	if _, ok := simulations[name]; ok {
+		panic(fmt.Sprintf("simulation suite %s is already registered", name))
+	}
 	simulations[name] = suite
 }
 
@@ -67,6 +86,7 @@ func GetSimulationSuites() []string {
 	for name := range simulations {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
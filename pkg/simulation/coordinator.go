@@ -17,6 +17,7 @@ package simulation
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,6 +53,11 @@ func (c *Coordinator) Run() (int, error) {
 	} else {
 		suites = []string{c.config.Simulation}
 	}
+	for _, suite := range suites {
+		if registry.GetSimulationSuite(suite) == nil {
+			return 1, fmt.Errorf("unknown simulation suite %s: available suites are %s", suite, strings.Join(registry.GetSimulationSuites(), ", "))
+		}
+	}
 
 	var returnCode int
 	for _, suite := range suites {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/onosproject/helmit/pkg/helm"
+	jobs "github.com/onosproject/helmit/pkg/job"
 	"github.com/onosproject/helmit/pkg/registry"
 	"github.com/onosproject/helmit/pkg/util/logging"
 	"google.golang.org/grpc"
@@ -279,6 +280,9 @@ func (s *simulatorServer) Run() error {
 	}
 	server := grpc.NewServer()
 	RegisterSimulatorServiceServer(server, s)
+	if err := jobs.SetServerReady(); err != nil {
+		return err
+	}
 	return server.Serve(lis)
 }
 
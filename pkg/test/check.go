@@ -0,0 +1,104 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var checks = make(map[*testing.T]*Checks)
+var checksMu sync.Mutex
+
+// Check returns the soft assertion collector for the given test
+// Failed checks do not stop the test. Instead, all failures are reported together when the test method returns.
+// The collector implements testify's assert.TestingT, so it can be passed to any assert function:
+//
+//	assert.Equal(s.Check(t), expected, actual)
+func (s Suite) Check(t *testing.T) *Checks {
+	checksMu.Lock()
+	defer checksMu.Unlock()
+	c, ok := checks[t]
+	if !ok {
+		c = &Checks{}
+		checks[t] = c
+	}
+	return c
+}
+
+// reportChecks fails the given test with all the failures accumulated by its checks
+func reportChecks(t *testing.T) {
+	checksMu.Lock()
+	c, ok := checks[t]
+	delete(checks, t)
+	checksMu.Unlock()
+	if !ok {
+		return
+	}
+
+	failures := c.Failures()
+	if len(failures) == 0 {
+		return
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d checks failed:", len(failures))
+	for i, failure := range failures {
+		fmt.Fprintf(&b, "\n  %d. %s", i+1, strings.ReplaceAll(failure, "\n", "\n     "))
+	}
+	t.Error(b.String())
+}
+
+// Checks accumulates the failures of soft assertions within a test
+type Checks struct {
+	failures []string
+	mu       sync.Mutex
+}
+
+// Errorf records a failure
+func (c *Checks) Errorf(format string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures = append(c.failures, strings.TrimSpace(fmt.Sprintf(format, args...)))
+}
+
+// That records a failure with the given message if the condition is false
+func (c *Checks) That(condition bool, format string, args ...interface{}) bool {
+	if !condition {
+		c.Errorf(format, args...)
+	}
+	return condition
+}
+
+// Equal records a failure if the expected and actual values are not deeply equal
+func (c *Checks) Equal(expected, actual interface{}, msg string) bool {
+	return c.That(reflect.DeepEqual(expected, actual), "%s: expected %v, got %v", msg, expected, actual)
+}
+
+// NoError records a failure if the given error is not nil
+func (c *Checks) NoError(err error, msg string) bool {
+	return c.That(err == nil, "%s: unexpected error: %v", msg, err)
+}
+
+// Failures returns the failures recorded so far
+func (c *Checks) Failures() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	failures := make([]string, len(c.failures))
+	copy(failures, c.failures)
+	return failures
+}
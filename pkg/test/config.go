@@ -17,6 +17,8 @@ package test
 import (
 	"github.com/onosproject/helmit/pkg/job"
 	"os"
+	"strconv"
+	"time"
 )
 
 type testType string
@@ -24,6 +26,9 @@ type testType string
 const (
 	testTypeEnv = "TEST_TYPE"
 	testJobType = "test"
+
+	testShardEnv  = "TEST_SHARD"
+	testShardsEnv = "TEST_SHARDS"
 )
 
 const (
@@ -33,15 +38,35 @@ const (
 
 // Config is a test configuration
 type Config struct {
-	*job.Config `json:",inline"`
-	Suites      []string          `json:"suites,omitempty"`
-	Tests       []string          `json:"tests,omitempty"`
-	Iterations  int               `json:"iterations,omitempty"`
-	Verbose     bool              `json:"verbose,omitempty"`
-	NoTeardown  bool              `json:"noteardown,omitempty"`
-	Args        map[string]string `json:"args,omitempty"`
+	*job.Config       `json:",inline"`
+	Suites            []string          `json:"suites,omitempty"`
+	Tests             []string          `json:"tests,omitempty"`
+	Iterations        int               `json:"iterations,omitempty"`
+	Verbose           bool              `json:"verbose,omitempty"`
+	NoTeardown        bool              `json:"noteardown,omitempty"`
+	Args              map[string]string `json:"args,omitempty"`
+	Flags             []string          `json:"flags,omitempty"`
+	RequireClean      bool              `json:"requireClean,omitempty"`
+	PreCommand        string            `json:"preCommand,omitempty"`
+	TestTimeout       time.Duration     `json:"testTimeout,omitempty"`
+	Shards            int               `json:"shards,omitempty"`
+	ArtifactsDir      string            `json:"artifactsDir,omitempty"`
+	SnapshotFile      string            `json:"snapshotFile,omitempty"`
+	RestrictNamespace bool              `json:"restrictNamespace,omitempty"`
+	TraceRequests     string            `json:"traceRequests,omitempty"`
+	Settle            time.Duration     `json:"settle,omitempty"`
+	CaptureOutput     bool              `json:"captureOutput,omitempty"`
+	VerifyTeardown    string            `json:"verifyTeardown,omitempty"`
+	MaxConcurrentPods int               `json:"maxConcurrentPods,omitempty"`
 }
 
+const (
+	// VerifyTeardownWarn logs the resources left in the test namespace after a suite is torn down
+	VerifyTeardownWarn = "warn"
+	// VerifyTeardownFail fails the suite if resources are left in the test namespace after it's torn down
+	VerifyTeardownFail = "fail"
+)
+
 // getTestContext returns the current test context
 func getTestType() testType {
 	context := os.Getenv(testTypeEnv)
@@ -50,3 +75,16 @@ func getTestType() testType {
 	}
 	return testTypeCoordinator
 }
+
+// getTestShard returns the index of the shard of tests run by the current worker and the total number of shards
+func getTestShard() (int, int) {
+	shards, err := strconv.Atoi(os.Getenv(testShardsEnv))
+	if err != nil || shards < 1 {
+		return 0, 1
+	}
+	shard, err := strconv.Atoi(os.Getenv(testShardEnv))
+	if err != nil {
+		return 0, 1
+	}
+	return shard, shards
+}
@@ -18,11 +18,14 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/onosproject/onos-lib-go/pkg/grpc/retry"
 
 	"github.com/onosproject/helmit/pkg/job"
+	"github.com/onosproject/helmit/pkg/kubernetes/config"
 	"github.com/onosproject/helmit/pkg/registry"
+	"github.com/onosproject/helmit/pkg/util/async"
 	"google.golang.org/grpc"
 )
 
@@ -48,41 +51,13 @@ func (c *Coordinator) Run() (int, error) {
 		if len(suites) == 0 || suites[0] == "" {
 			suites = registry.GetTestSuites()
 		}
+		if err := validateSuites(suites); err != nil {
+			return 1, err
+		}
 		returnCode = 0
 		for _, suite := range suites {
 			jobID := newJobID(c.config.ID+"-"+strconv.Itoa(iteration), suite)
-			env := c.config.Env
-			if env == nil {
-				env = make(map[string]string)
-			}
-			env[testTypeEnv] = string(testTypeWorker)
-			config := &Config{
-				Config: &job.Config{
-					ID:              jobID,
-					Namespace:       c.config.Config.Namespace,
-					ServiceAccount:  c.config.Config.ServiceAccount,
-					Image:           c.config.Config.Image,
-					ImagePullPolicy: c.config.Config.ImagePullPolicy,
-					Executable:      c.config.Config.Executable,
-					Context:         c.config.Config.Context,
-					Values:          c.config.Config.Values,
-					ValueFiles:      c.config.Config.ValueFiles,
-					Env:             env,
-					Timeout:         c.config.Config.Timeout,
-					NoTeardown:      c.config.Config.NoTeardown,
-					Secrets:         c.config.Config.Secrets,
-					Args:            c.config.Config.Args,
-				},
-				Suites:     []string{suite},
-				Tests:      c.config.Tests,
-				Iterations: c.config.Iterations,
-				Args:       c.config.Args,
-			}
-			task := &WorkerTask{
-				runner: c.runner,
-				config: config,
-			}
-			status, err := task.Run()
+			status, err := c.runSuite(suite, jobID)
 			if err != nil {
 				return status, err
 			} else if returnCode == 0 {
@@ -96,6 +71,115 @@ func (c *Coordinator) Run() (int, error) {
 	return returnCode, nil
 }
 
+// runSuite runs the given suite, sharding its tests across workers if configured
+func (c *Coordinator) runSuite(suite string, jobID string) (int, error) {
+	shards := c.config.Shards
+	if shards < 1 {
+		shards = 1
+	}
+	statuses, err := async.ExecuteOrderedAsyncLimit(shards, c.config.MaxConcurrentPods, func(shard int) (interface{}, error) {
+		if shards == 1 {
+			task := &WorkerTask{
+				runner: c.runner,
+				config: c.newWorkerConfig(suite, jobID, c.config.Namespace, shard, shards),
+			}
+			return task.Run()
+		}
+		return c.runShard(suite, fmt.Sprintf("%s-%d", jobID, shard), shard, shards)
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, status := range statuses {
+		if status.(int) != 0 {
+			return status.(int), nil
+		}
+	}
+	return 0, nil
+}
+
+// runShard runs the given shard of a suite in its own namespace, named for the shard's job
+// Shards run concurrently, so running them in the test namespace would let the suite setup and
+// teardown of one shard collide with those of the others.
+func (c *Coordinator) runShard(suite string, jobID string, shard int, shards int) (int, error) {
+	if err := createShardNamespace(c.runner, jobID); err != nil {
+		return 0, err
+	}
+	task := &WorkerTask{
+		runner: job.NewNamespace(jobID),
+		config: c.newWorkerConfig(suite, jobID, jobID, shard, shards),
+	}
+	status, err := task.Run()
+	if !c.config.Config.NoTeardown {
+		if deleteErr := deleteShardNamespace(c.runner, jobID); deleteErr != nil && err == nil {
+			err = deleteErr
+		}
+	}
+	return status, err
+}
+
+// newWorkerConfig returns the configuration for the worker running the given shard of the suite
+func (c *Coordinator) newWorkerConfig(suite string, jobID string, namespace string, shard int, shards int) *Config {
+	env := make(map[string]string)
+	for key, value := range c.config.Env {
+		env[key] = value
+	}
+	env[testTypeEnv] = string(testTypeWorker)
+	env[testShardEnv] = strconv.Itoa(shard)
+	env[testShardsEnv] = strconv.Itoa(shards)
+	if c.config.RestrictNamespace {
+		env[config.RestrictNamespaceEnv] = "true"
+	}
+	if c.config.TraceRequests != "" {
+		env[config.TraceRequestsEnv] = c.config.TraceRequests
+	}
+	return &Config{
+		Config: &job.Config{
+			ID:              jobID,
+			Namespace:       namespace,
+			ServiceAccount:  c.config.Config.ServiceAccount,
+			Labels:          c.config.Config.Labels,
+			Annotations:     c.config.Config.Annotations,
+			Image:           c.config.Config.Image,
+			ImagePullPolicy: c.config.Config.ImagePullPolicy,
+			Executable:      c.config.Config.Executable,
+			Context:         c.config.Config.Context,
+			Values:          c.config.Config.Values,
+			StringValues:    c.config.Config.StringValues,
+			ValueFiles:      c.config.Config.ValueFiles,
+			Env:             env,
+			Timeout:         c.config.Config.Timeout,
+			NoTeardown:      c.config.Config.NoTeardown,
+			Secrets:         c.config.Config.Secrets,
+			Args:            c.config.Config.Args,
+			WaitContainers:  c.config.Config.WaitContainers,
+		},
+		Suites:         []string{suite},
+		Tests:          c.config.Tests,
+		Iterations:     c.config.Iterations,
+		Args:           c.config.Args,
+		Flags:          c.config.Flags,
+		RequireClean:   c.config.RequireClean,
+		PreCommand:     c.config.PreCommand,
+		TestTimeout:    c.config.TestTimeout,
+		ArtifactsDir:   c.config.ArtifactsDir,
+		SnapshotFile:   c.config.SnapshotFile,
+		Settle:         c.config.Settle,
+		CaptureOutput:  c.config.CaptureOutput,
+		VerifyTeardown: c.config.VerifyTeardown,
+	}
+}
+
+// validateSuites returns an error listing the available suites if any of the given suites is not registered
+func validateSuites(suites []string) error {
+	for _, suite := range suites {
+		if registry.GetTestSuite(suite) == nil {
+			return fmt.Errorf("unknown test suite %s: available suites are %s", suite, strings.Join(registry.GetTestSuites(), ", "))
+		}
+	}
+	return nil
+}
+
 // newJobID returns a new unique test job ID
 func newJobID(testID, suite string) string {
 	return fmt.Sprintf("%s-%s", testID, suite)
@@ -120,7 +204,7 @@ func (t *WorkerTask) Run() (int, error) {
 		return 0, err
 	}
 
-	address := fmt.Sprintf("%s:5000", job.ID)
+	address := fmt.Sprintf("%s.%s:5000", job.ID, t.runner.Namespace())
 	conn, err := grpc.Dial(address,
 		grpc.WithUnaryInterceptor(retry.RetryingUnaryClientInterceptor()),
 		grpc.WithStreamInterceptor(retry.RetryingStreamClientInterceptor()),
@@ -0,0 +1,111 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onosproject/helmit/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxDiagnosticEvents is the maximum number of recent events captured in diagnostics
+const maxDiagnosticEvents = 50
+
+// captureDiagnostics returns a description of the pods, deployments, and recent events in the test namespace
+// The description is intended to show what a test was waiting on when it timed out.
+func captureDiagnostics() string {
+	client, err := kubernetes.New()
+	if err != nil {
+		return fmt.Sprintf("failed to capture diagnostics: %v", err)
+	}
+
+	ctx := context.Background()
+	namespace := client.Namespace()
+	clientset := client.Clientset()
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Pods in namespace %s:\n", namespace)
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(&b, "  failed to list pods: %v\n", err)
+	} else {
+		for _, pod := range pods.Items {
+			describePod(&b, pod)
+		}
+	}
+
+	fmt.Fprintf(&b, "Deployments in namespace %s:\n", namespace)
+	deployments, err := clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(&b, "  failed to list deployments: %v\n", err)
+	} else {
+		for _, deployment := range deployments.Items {
+			var replicas int32 = 1
+			if deployment.Spec.Replicas != nil {
+				replicas = *deployment.Spec.Replicas
+			}
+			fmt.Fprintf(&b, "  %s: %d/%d ready, %d updated, %d available\n", deployment.Name,
+				deployment.Status.ReadyReplicas, replicas, deployment.Status.UpdatedReplicas, deployment.Status.AvailableReplicas)
+			for _, condition := range deployment.Status.Conditions {
+				if condition.Status != corev1.ConditionTrue {
+					fmt.Fprintf(&b, "    %s=%s: %s\n", condition.Type, condition.Status, condition.Message)
+				}
+			}
+		}
+	}
+
+	fmt.Fprintf(&b, "Recent events in namespace %s:\n", namespace)
+	events, err := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(&b, "  failed to list events: %v\n", err)
+	} else {
+		items := events.Items
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].LastTimestamp.Before(&items[j].LastTimestamp)
+		})
+		if len(items) > maxDiagnosticEvents {
+			items = items[len(items)-maxDiagnosticEvents:]
+		}
+		for _, event := range items {
+			fmt.Fprintf(&b, "  %s %s %s/%s %s: %s\n", event.LastTimestamp.Format("15:04:05"), event.Type,
+				strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name, event.Reason, event.Message)
+		}
+	}
+	return b.String()
+}
+
+// describePod writes a summary of the state of the given pod and its containers
+func describePod(b *strings.Builder, pod corev1.Pod) {
+	fmt.Fprintf(b, "  %s: %s\n", pod.Name, pod.Status.Phase)
+	for _, condition := range pod.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			fmt.Fprintf(b, "    %s=%s: %s %s\n", condition.Type, condition.Status, condition.Reason, condition.Message)
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		state := "running"
+		if status.State.Waiting != nil {
+			state = fmt.Sprintf("waiting: %s %s", status.State.Waiting.Reason, status.State.Waiting.Message)
+		} else if status.State.Terminated != nil {
+			state = fmt.Sprintf("terminated: %s (exit code %d)", status.State.Terminated.Reason, status.State.Terminated.ExitCode)
+		}
+		fmt.Fprintf(b, "    container %s: ready=%t restarts=%d %s\n", status.Name, status.Ready, status.RestartCount, state)
+	}
+}
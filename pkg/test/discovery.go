@@ -0,0 +1,68 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onosproject/helmit/pkg/kubernetes"
+)
+
+// ArgProvider computes the value of a suite argument from the state of the cluster
+type ArgProvider func(client kubernetes.Client) (string, error)
+
+// ArgProviders is an interface for suites that discover arguments from the cluster
+// The providers are run before the suite is set up, and the values they return are passed to SetupTestSuite
+// in the input context. Arguments passed to the test command take precedence over discovered arguments.
+type ArgProviders interface {
+	ArgProviders() map[string]ArgProvider
+}
+
+// discoverArgs returns the given arguments merged with the arguments discovered by the suite's providers
+func discoverArgs(suite TestingSuite, args map[string]string) (map[string]string, error) {
+	providers, ok := suite.(ArgProviders)
+	if !ok {
+		return args, nil
+	}
+
+	client, err := kubernetes.New()
+	if err != nil {
+		return nil, err
+	}
+
+	discovered := make(map[string]string)
+	for name, value := range args {
+		discovered[name] = value
+	}
+
+	argProviders := providers.ArgProviders()
+	names := make([]string, 0, len(argProviders))
+	for name := range argProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := discovered[name]; ok {
+			continue
+		}
+		value, err := argProviders[name](client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover argument %s: %v", name, err)
+		}
+		discovered[name] = value
+	}
+	return discovered, nil
+}
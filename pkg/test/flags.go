@@ -0,0 +1,110 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/onosproject/helmit/pkg/registry"
+)
+
+// PrintFlagsEnv is the environment variable used to request the binary print suite flags and exit
+const PrintFlagsEnv = "HELMIT_PRINT_FLAGS"
+
+// SetupFlags is an interface for declaring custom suite flags
+// Flags are declared on the given FlagSet and parsed from the arguments following
+// "--" on the helmit test command line before the suite is set up.
+type SetupFlags interface {
+	SetupFlags(flags *flag.FlagSet)
+}
+
+// newFlagSet returns the flag set for the given suite, or nil if the suite declares no flags
+func newFlagSet(name string, suite TestingSuite) *flag.FlagSet {
+	setupFlags, ok := suite.(SetupFlags)
+	if !ok {
+		return nil
+	}
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	setupFlags.SetupFlags(flags)
+	return flags
+}
+
+// parseFlags parses the flags declared by the given suite
+// Arguments for flags the suite does not declare are ignored since they may be intended for another suite.
+func parseFlags(name string, suite TestingSuite, args []string) error {
+	flags := newFlagSet(name, suite)
+	if flags == nil {
+		return nil
+	}
+	flags.SetOutput(ioutil.Discard)
+
+	suiteArgs := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		flagName := strings.TrimLeft(arg, "-")
+		if index := strings.Index(flagName, "="); index != -1 {
+			flagName = flagName[:index]
+		}
+		f := flags.Lookup(flagName)
+		if f == nil {
+			continue
+		}
+		suiteArgs = append(suiteArgs, arg)
+
+		// Include the value of flags specified as separate arguments
+		if !strings.Contains(arg, "=") && !isBoolFlag(f) && i+1 < len(args) {
+			suiteArgs = append(suiteArgs, args[i+1])
+			i++
+		}
+	}
+
+	if err := flags.Parse(suiteArgs); err != nil {
+		return fmt.Errorf("invalid flags for suite %s: %v", name, err)
+	}
+	return nil
+}
+
+// isBoolFlag returns whether the given flag is a boolean flag
+func isBoolFlag(f *flag.Flag) bool {
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && boolFlag.IsBoolFlag()
+}
+
+// printFlags prints the flags declared by all registered suites
+func printFlags(out io.Writer) {
+	suites := registry.GetTestSuites()
+	sort.Strings(suites)
+	for _, name := range suites {
+		flags := newFlagSet(name, registry.GetTestSuite(name))
+		if flags == nil {
+			continue
+		}
+		fmt.Fprintf(out, "Suite %s flags:\n", name)
+		flags.SetOutput(out)
+		flags.PrintDefaults()
+		fmt.Fprintln(out)
+	}
+}
+
+// shouldPrintFlags returns whether the binary was run to print suite flags
+func shouldPrintFlags() bool {
+	return os.Getenv(PrintFlagsEnv) != ""
+}
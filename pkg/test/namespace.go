@@ -0,0 +1,205 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onosproject/helmit/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ignoredServiceAccounts are service accounts created by Kubernetes and Helmit in every test namespace
+var ignoredServiceAccounts = map[string]bool{
+	"default":      true,
+	"cluster-test": true,
+}
+
+// ignoredConfigMaps are config maps created by Kubernetes in every namespace
+var ignoredConfigMaps = map[string]bool{
+	"kube-root-ca.crt": true,
+}
+
+// ignoredSecrets are secrets created by Helmit in every test namespace
+var ignoredSecrets = map[string]bool{
+	"helmit-secrets": true,
+}
+
+// RequireEmptyNamespace returns an error listing the resources present in the test namespace
+// Resources created by Kubernetes and by Helmit itself to run the tests are ignored. The method is
+// intended to be called from SetupTestSuite to fail fast when resources from a prior run remain.
+func (s Suite) RequireEmptyNamespace() error {
+	return requireEmptyNamespace()
+}
+
+// requireEmptyNamespace returns an error if the test namespace contains any resources
+func requireEmptyNamespace() error {
+	client, err := kubernetes.New()
+	if err != nil {
+		return err
+	}
+	resources, err := listNamespaceResources(client)
+	if err != nil {
+		return err
+	}
+	if len(resources) > 0 {
+		sort.Strings(resources)
+		return fmt.Errorf("namespace %s is not empty:\n  %s", client.Namespace(), strings.Join(resources, "\n  "))
+	}
+	return nil
+}
+
+// listNamespaceResources lists the names of user resources in the client's namespace
+func listNamespaceResources(client kubernetes.Client) ([]string, error) {
+	ctx := context.Background()
+	namespace := client.Namespace()
+	clientset := client.Clientset()
+	var resources []string
+
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		if !isJobObject(pod.ObjectMeta) {
+			resources = append(resources, "pod/"+pod.Name)
+		}
+	}
+
+	services, err := clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services.Items {
+		if !isJobObject(service.ObjectMeta) {
+			resources = append(resources, "service/"+service.Name)
+		}
+	}
+
+	configMaps, err := clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, configMap := range configMaps.Items {
+		if !isJobObject(configMap.ObjectMeta) && !ignoredConfigMaps[configMap.Name] {
+			resources = append(resources, "configmap/"+configMap.Name)
+		}
+	}
+
+	secrets, err := clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets.Items {
+		if !isJobObject(secret.ObjectMeta) && !ignoredSecrets[secret.Name] && secret.Type != corev1.SecretTypeServiceAccountToken {
+			resources = append(resources, "secret/"+secret.Name)
+		}
+	}
+
+	serviceAccounts, err := clientset.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, serviceAccount := range serviceAccounts.Items {
+		if !ignoredServiceAccounts[serviceAccount.Name] {
+			resources = append(resources, "serviceaccount/"+serviceAccount.Name)
+		}
+	}
+
+	claims, err := clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range claims.Items {
+		resources = append(resources, "persistentvolumeclaim/"+claim.Name)
+	}
+
+	deployments, err := clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		resources = append(resources, "deployment/"+deployment.Name)
+	}
+
+	statefulSets, err := clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, statefulSet := range statefulSets.Items {
+		resources = append(resources, "statefulset/"+statefulSet.Name)
+	}
+
+	daemonSets, err := clientset.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, daemonSet := range daemonSets.Items {
+		resources = append(resources, "daemonset/"+daemonSet.Name)
+	}
+
+	jobs, err := clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs.Items {
+		if !isJobObject(job.ObjectMeta) {
+			resources = append(resources, "job/"+job.Name)
+		}
+	}
+	return resources, nil
+}
+
+// isJobObject returns whether the given object was created by Helmit to run a job
+func isJobObject(meta metav1.ObjectMeta) bool {
+	// Helm release secrets carry the labels of the job that installed the release
+	if meta.Labels["owner"] == "helm" {
+		return false
+	}
+	if _, ok := meta.Labels["job"]; ok {
+		_, ok := meta.Labels["type"]
+		return ok
+	}
+	if _, ok := meta.Annotations["job"]; ok {
+		_, ok := meta.Annotations["type"]
+		return ok
+	}
+	return false
+}
+
+// createShardNamespace creates the namespace in which a shard of a suite is run
+// Each shard of a sharded suite runs in its own namespace so the suite setup and teardown run by
+// concurrent shards don't collide.
+func createShardNamespace(client kubernetes.Client, name string) error {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"helmit-shard-of": client.Namespace(),
+			},
+		},
+	}
+	_, err := client.Clientset().CoreV1().Namespaces().Create(context.Background(), namespace, metav1.CreateOptions{})
+	return err
+}
+
+// deleteShardNamespace deletes the namespace in which a shard of a suite was run
+func deleteShardNamespace(client kubernetes.Client, name string) error {
+	return client.Clientset().CoreV1().Namespaces().Delete(context.Background(), name, metav1.DeleteOptions{})
+}
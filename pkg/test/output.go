@@ -0,0 +1,138 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/onosproject/helmit/pkg/job"
+)
+
+// outputTestMarker is written to captured streams to mark the test to which subsequent output belongs
+// Writing the marker through the stream rather than recording the test in a variable ensures output
+// written before the test changed is attributed to the right test. The marker may follow a partial line,
+// which is attributed to the previous test.
+const outputTestMarker = "\x00helmit-test:"
+
+// outputCapture captures the stdout and stderr of the process, tagging each line with the stream and
+// the test that wrote it
+type outputCapture struct {
+	stdout *capturedStream
+	stderr *capturedStream
+}
+
+// captureOutput starts capturing stdout and stderr
+func captureOutput() (*outputCapture, error) {
+	stdout, err := newCapturedStream(job.StdoutStream, os.Stdout)
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := newCapturedStream(job.StderrStream, os.Stderr)
+	if err != nil {
+		stdout.close()
+		return nil, err
+	}
+	os.Stdout = stdout.writer
+	os.Stderr = stderr.writer
+	log.SetOutput(os.Stderr)
+	return &outputCapture{
+		stdout: stdout,
+		stderr: stderr,
+	}, nil
+}
+
+// setTest sets the test to which subsequent output is attributed
+func (c *outputCapture) setTest(name string) {
+	c.stdout.setTest(name)
+	c.stderr.setTest(name)
+}
+
+// stop stops capturing output, waiting for captured output to be written
+// Output written after the capture is stopped, e.g. the testing package's summary, is written to the original
+// files untagged.
+func (c *outputCapture) stop() {
+	os.Stdout = c.stdout.original
+	os.Stderr = c.stderr.original
+	log.SetOutput(os.Stderr)
+	c.stdout.close()
+	c.stderr.close()
+}
+
+// newCapturedStream returns a new stream capturing output written in place of the given file
+func newCapturedStream(name string, original *os.File) (*capturedStream, error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	stream := &capturedStream{
+		name:     name,
+		original: original,
+		writer:   writer,
+		done:     make(chan struct{}),
+	}
+	go stream.copy(reader)
+	return stream, nil
+}
+
+// capturedStream is a captured output stream
+type capturedStream struct {
+	name     string
+	original *os.File
+	writer   *os.File
+	done     chan struct{}
+}
+
+// setTest marks the test to which subsequent output written to the stream is attributed
+func (s *capturedStream) setTest(name string) {
+	fmt.Fprintf(s.writer, "%s%s\n", outputTestMarker, name)
+}
+
+// copy copies tagged lines from the given reader to the original file
+func (s *capturedStream) copy(reader *os.File) {
+	defer close(s.done)
+	defer reader.Close()
+	lines := bufio.NewReader(reader)
+	var test string
+	for {
+		line, err := lines.ReadString('\n')
+		line = strings.TrimSuffix(line, "\n")
+		if i := strings.Index(line, outputTestMarker); i >= 0 {
+			if i > 0 {
+				fmt.Fprintln(s.original, job.FormatOutput(s.name, test, line[:i]))
+			}
+			test = line[i+len(outputTestMarker):]
+		} else if line != "" || err == nil {
+			fmt.Fprintln(s.original, job.FormatOutput(s.name, test, line))
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// close closes the stream, waiting for the captured output to be written
+// The testing package binds its output to the captured file when the tests start and keeps writing to it after the
+// capture is stopped. So rather than closing the file, it's redirected to the original file where supported, which
+// closes the pipe while keeping later writes.
+func (s *capturedStream) close() {
+	if err := redirectFile(s.writer, s.original); err != nil {
+		s.writer.Close()
+	}
+	<-s.done
+}
@@ -0,0 +1,110 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// AssertQuantityLessThan fails the test if the actual quantity is not less than the given limit
+// Quantities may be given as a resource.Quantity, a *resource.Quantity, or a string such as "500Mi".
+//
+//	s.AssertQuantityLessThan(t, pod.Spec.Containers[0].Resources.Requests.Memory(), "500Mi")
+func (s Suite) AssertQuantityLessThan(t *testing.T, actual, limit interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	actualQ, limitQ, ok := parseQuantities(t, actual, limit)
+	if !ok {
+		return false
+	}
+	if actualQ.Cmp(limitQ) >= 0 {
+		t.Errorf("%sexpected %s to be less than %s", formatQuantityMessage(msgAndArgs), actualQ.String(), limitQ.String())
+		return false
+	}
+	return true
+}
+
+// AssertQuantityInRange fails the test if the actual quantity is not within the given inclusive bounds
+// Quantities may be given as a resource.Quantity, a *resource.Quantity, or a string such as "250m".
+//
+//	s.AssertQuantityInRange(t, usage.Cpu(), "100m", "500m")
+func (s Suite) AssertQuantityInRange(t *testing.T, actual, min, max interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	actualQ, minQ, ok := parseQuantities(t, actual, min)
+	if !ok {
+		return false
+	}
+	maxQ, ok := parseQuantity(t, max)
+	if !ok {
+		return false
+	}
+	if actualQ.Cmp(minQ) < 0 || actualQ.Cmp(maxQ) > 0 {
+		t.Errorf("%sexpected %s to be in range [%s, %s]", formatQuantityMessage(msgAndArgs), actualQ.String(), minQ.String(), maxQ.String())
+		return false
+	}
+	return true
+}
+
+// parseQuantities parses a pair of quantities, failing the test if either is invalid
+func parseQuantities(t *testing.T, a, b interface{}) (resource.Quantity, resource.Quantity, bool) {
+	t.Helper()
+	aQ, ok := parseQuantity(t, a)
+	if !ok {
+		return resource.Quantity{}, resource.Quantity{}, false
+	}
+	bQ, ok := parseQuantity(t, b)
+	if !ok {
+		return resource.Quantity{}, resource.Quantity{}, false
+	}
+	return aQ, bQ, true
+}
+
+// parseQuantity converts the given value to a resource.Quantity, failing the test if it's not a valid quantity
+func parseQuantity(t *testing.T, value interface{}) (resource.Quantity, bool) {
+	t.Helper()
+	switch q := value.(type) {
+	case resource.Quantity:
+		return q, true
+	case *resource.Quantity:
+		if q == nil {
+			t.Errorf("invalid quantity: nil")
+			return resource.Quantity{}, false
+		}
+		return *q, true
+	case string:
+		parsed, err := resource.ParseQuantity(q)
+		if err != nil {
+			t.Errorf("invalid quantity %q: %v", q, err)
+			return resource.Quantity{}, false
+		}
+		return parsed, true
+	default:
+		t.Errorf("invalid quantity %v: expected a resource.Quantity or string", value)
+		return resource.Quantity{}, false
+	}
+}
+
+// formatQuantityMessage formats the optional message of a quantity assertion
+func formatQuantityMessage(msgAndArgs []interface{}) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+	if format, ok := msgAndArgs[0].(string); ok {
+		return fmt.Sprintf(format, msgAndArgs[1:]...) + ": "
+	}
+	return fmt.Sprint(msgAndArgs...) + ": "
+}
@@ -17,8 +17,12 @@ package test
 import (
 	"fmt"
 	jobs "github.com/onosproject/helmit/pkg/job"
+	kubeconfig "github.com/onosproject/helmit/pkg/kubernetes/config"
+	"github.com/onosproject/helmit/pkg/util/logging"
 	"os"
+	"os/exec"
 	"path"
+	"text/tabwriter"
 )
 
 // The executor is the entrypoint for test images. It takes the input and environment and runs
@@ -26,6 +30,39 @@ import (
 
 // Run runs the test
 func Run(config *Config) error {
+	return jobs.Run(newJob(config))
+}
+
+// RunImages runs the tests in each of the given configs as a separate job and prints a combined summary
+// The jobs are run in sequence so their logs are not interleaved. The returned exit status is non-zero
+// if the tests in any image failed.
+func RunImages(configs []*Config) int {
+	statuses := make([]int, len(configs))
+	errs := make([]error, len(configs))
+	for i, config := range configs {
+		statuses[i], errs[i] = jobs.RunForStatus(newJob(config))
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(writer, "IMAGE\tID\tRESULT")
+	exitStatus := 0
+	for i, config := range configs {
+		result := "PASSED"
+		if errs[i] != nil {
+			result = fmt.Sprintf("ERROR: %v", errs[i])
+			exitStatus = 1
+		} else if statuses[i] != 0 {
+			result = fmt.Sprintf("FAILED (exit status %d)", statuses[i])
+			exitStatus = 1
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", config.Image, config.ID, result)
+	}
+	writer.Flush()
+	return exitStatus
+}
+
+// newJob returns the job for the given test configuration
+func newJob(config *Config) *jobs.Job {
 	configValueFiles := make(map[string][]string)
 	if config.ValueFiles != nil {
 		for release, valueFiles := range config.ValueFiles {
@@ -47,38 +84,74 @@ func Run(config *Config) error {
 		configContext = path.Base(config.Context)
 	}
 
-	job := &jobs.Job{
-		Config: config.Config,
+	// Snapshots and request traces are written by the workers to their output, relayed by the coordinator,
+	// and from there written to their local files
+	outputFiles := make(map[string]string)
+	if config.SnapshotFile != "" {
+		outputFiles[snapshotStream] = config.SnapshotFile
+	}
+	traceRequests := config.TraceRequests
+	if traceRequests != "" && traceRequests != "stdout" {
+		outputFiles[kubeconfig.TraceStream] = traceRequests
+		traceRequests = kubeconfig.TraceStream
+	}
+	jobConfig := *config.Config
+	if len(outputFiles) > 0 {
+		jobConfig.OutputFiles = outputFiles
+	}
+
+	return &jobs.Job{
+		Config: &jobConfig,
 		JobConfig: &Config{
 			Config: &jobs.Config{
 				ID:              config.ID,
 				Namespace:       config.Namespace,
 				ServiceAccount:  config.ServiceAccount,
+				Labels:          config.Labels,
+				Annotations:     config.Annotations,
 				Image:           config.Image,
 				ImagePullPolicy: config.ImagePullPolicy,
 				Executable:      configExecutable,
 				Context:         configContext,
 				Values:          config.Values,
+				StringValues:    config.StringValues,
 				ValueFiles:      configValueFiles,
 				Args:            config.Config.Args,
 				Env:             config.Env,
 				Timeout:         config.Timeout,
 				NoTeardown:      config.NoTeardown,
 				Secrets:         config.Secrets,
+				WaitContainers:  config.WaitContainers,
 			},
-			Suites:     config.Suites,
-			Tests:      config.Tests,
-			Iterations: config.Iterations,
-			Verbose:    config.Verbose,
-			Args:       config.Args,
+			Suites:            config.Suites,
+			Tests:             config.Tests,
+			Iterations:        config.Iterations,
+			Verbose:           config.Verbose,
+			Args:              config.Args,
+			Flags:             config.Flags,
+			RequireClean:      config.RequireClean,
+			PreCommand:        config.PreCommand,
+			TestTimeout:       config.TestTimeout,
+			Shards:            config.Shards,
+			ArtifactsDir:      config.ArtifactsDir,
+			SnapshotFile:      config.SnapshotFile,
+			RestrictNamespace: config.RestrictNamespace,
+			TraceRequests:     traceRequests,
+			Settle:            config.Settle,
+			CaptureOutput:     config.CaptureOutput,
+			VerifyTeardown:    config.VerifyTeardown,
+			MaxConcurrentPods: config.MaxConcurrentPods,
 		},
 		Type: testJobType,
 	}
-	return jobs.Run(job)
 }
 
 // Main runs a test
 func Main() {
+	if shouldPrintFlags() {
+		printFlags(os.Stdout)
+		os.Exit(0)
+	}
 	if err := run(); err != nil {
 		println("Test run failed " + err.Error())
 		os.Exit(1)
@@ -119,9 +192,33 @@ func runCoordinator(config *Config) error {
 
 // runWorker runs a test image in the worker context
 func runWorker(config *Config) error {
+	if err := runPreCommand(config); err != nil {
+		return err
+	}
 	worker, err := newWorker(config)
 	if err != nil {
 		return err
 	}
 	return worker.Run()
 }
+
+// runPreCommand runs the configured pre-command in the test context
+func runPreCommand(config *Config) error {
+	if config.PreCommand == "" {
+		return nil
+	}
+
+	step := logging.NewStep(config.ID, "Run pre-command %s", config.PreCommand)
+	step.Start()
+	cmd := exec.Command("sh", "-c", config.PreCommand)
+	cmd.Dir = config.Context
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		err = fmt.Errorf("pre-command %s failed: %v", config.PreCommand, err)
+		step.Fail(err)
+		return err
+	}
+	step.Complete()
+	return nil
+}
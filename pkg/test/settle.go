@@ -0,0 +1,38 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// settleTime is the time in nanoseconds to wait for the cluster to converge, configured with --settle
+var settleTime int64
+
+// setSettleTime sets the time to wait for the cluster to converge
+func setSettleTime(d time.Duration) {
+	atomic.StoreInt64(&settleTime, int64(d))
+}
+
+// Settle waits for the settle time configured with --settle for the cluster to converge after an operation,
+// e.g. scaling a deployment or upgrading a release. If no settle time is configured, Settle returns immediately.
+// Settling is a pragmatic guard against trivial timing flakes; waiting for the expected state with a predicate
+// is more reliable and should be preferred where possible.
+func (s Suite) Settle() {
+	if d := time.Duration(atomic.LoadInt64(&settleTime)); d > 0 {
+		time.Sleep(d)
+	}
+}
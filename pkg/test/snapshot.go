@@ -0,0 +1,116 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/onosproject/helmit/pkg/job"
+	"github.com/onosproject/helmit/pkg/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// snapshotStream is the name of the output stream on which snapshots are written for the snapshot file
+const snapshotStream = "snapshot"
+
+// snapshot is a structured snapshot of the resources in the test namespace
+type snapshot struct {
+	Test                   string                         `json:"test"`
+	Namespace              string                         `json:"namespace"`
+	Time                   time.Time                      `json:"time"`
+	Pods                   []corev1.Pod                   `json:"pods"`
+	Deployments            []appsv1.Deployment            `json:"deployments"`
+	PersistentVolumeClaims []corev1.PersistentVolumeClaim `json:"persistentVolumeClaims"`
+	Events                 []corev1.Event                 `json:"events"`
+	Errors                 []string                       `json:"errors,omitempty"`
+}
+
+// captureSnapshot captures a snapshot of the test namespace for the given test
+// Resources that cannot be listed are recorded as errors in the snapshot rather than failing the capture.
+func captureSnapshot(test string) (*snapshot, error) {
+	client, err := kubernetes.New()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	namespace := client.Namespace()
+	clientset := client.Clientset()
+	s := &snapshot{
+		Test:      test,
+		Namespace: namespace,
+		Time:      time.Now(),
+	}
+
+	if pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{}); err != nil {
+		s.Errors = append(s.Errors, fmt.Sprintf("failed to list pods: %v", err))
+	} else {
+		s.Pods = pods.Items
+	}
+	if deployments, err := clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{}); err != nil {
+		s.Errors = append(s.Errors, fmt.Sprintf("failed to list deployments: %v", err))
+	} else {
+		s.Deployments = deployments.Items
+	}
+	if claims, err := clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{}); err != nil {
+		s.Errors = append(s.Errors, fmt.Sprintf("failed to list persistent volume claims: %v", err))
+	} else {
+		s.PersistentVolumeClaims = claims.Items
+	}
+	if events, err := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{}); err != nil {
+		s.Errors = append(s.Errors, fmt.Sprintf("failed to list events: %v", err))
+	} else {
+		s.Events = events.Items
+	}
+
+	return s, nil
+}
+
+// writeSnapshot writes the given snapshot to the artifacts directory, keyed by test name
+func writeSnapshot(dir string, s *snapshot) (string, error) {
+	bytes, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s.json", strings.ReplaceAll(s.Test, "/", "_")))
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// streamSnapshot writes the given snapshot to the snapshot stream as a single line of JSON, from which
+// the CLI appends it to the snapshot file
+func streamSnapshot(out io.Writer, s *snapshot) error {
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, job.FormatOutput(snapshotStream, "", string(bytes)))
+	return err
+}
@@ -0,0 +1,73 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onosproject/helmit/pkg/kubernetes"
+)
+
+// teardownGracePeriod is the maximum time to wait for resources to be deleted once a suite is torn down
+const teardownGracePeriod = time.Minute
+
+// listResources lists the names of user resources in the test namespace
+func listResources() ([]string, error) {
+	client, err := kubernetes.New()
+	if err != nil {
+		return nil, err
+	}
+	return listNamespaceResources(client)
+}
+
+// verifyTeardownResources returns an error listing the resources in the test namespace that are not in the given
+// list of resources present before the suite was run
+// Resources are deleted asynchronously, so the namespace is polled until the leaked resources are gone or the
+// grace period expires.
+func verifyTeardownResources(before []string) error {
+	client, err := kubernetes.New()
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool)
+	for _, resource := range before {
+		existing[resource] = true
+	}
+
+	deadline := time.Now().Add(teardownGracePeriod)
+	for {
+		resources, err := listNamespaceResources(client)
+		if err != nil {
+			return err
+		}
+		var leaked []string
+		for _, resource := range resources {
+			if !existing[resource] {
+				leaked = append(leaked, resource)
+			}
+		}
+		if len(leaked) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			sort.Strings(leaked)
+			return fmt.Errorf("resources in namespace %s were not cleaned up after teardown:\n  %s", client.Namespace(), strings.Join(leaked, "\n  "))
+		}
+		time.Sleep(time.Second)
+	}
+}
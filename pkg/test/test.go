@@ -17,11 +17,14 @@ package test
 import (
 	"fmt"
 	"github.com/onosproject/helmit/pkg/input"
+	"hash/fnv"
 	"os"
 	"reflect"
 	"regexp"
 	"runtime/debug"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // TestingSuite is a suite of tests
@@ -70,10 +73,39 @@ func failTestOnPanic(t *testing.T) {
 
 // RunTests runs a test suite
 func RunTests(t *testing.T, suite TestingSuite, request *TestRequest) {
+	runSuite(t, suite, request, suiteOptions{})
+}
+
+// suiteOptions are options for running a test suite
+type suiteOptions struct {
+	// timeout is the maximum duration of each test
+	timeout time.Duration
+	// shard is the index of the shard of tests to run
+	shard int
+	// shards is the total number of shards across which tests are distributed
+	shards int
+	// artifactsDir is the directory to which namespace snapshots are written when a test fails
+	artifactsDir string
+	// streamSnapshots indicates whether to write namespace snapshots to the snapshot stream when a test fails
+	streamSnapshots bool
+	// settle is the time to wait for the cluster to converge after the suite and each test are set up
+	settle time.Duration
+	// capture is the capture of the process's output, if the stdout and stderr of each test are tagged
+	// with the stream and test name
+	capture *outputCapture
+}
+
+// runSuite runs the tests in the given shard of a test suite
+func runSuite(t *testing.T, suite TestingSuite, request *TestRequest, options suiteOptions) {
 	defer failTestOnPanic(t)
+	setSettleTime(options.settle)
 
+	capture := options.capture
 	suiteSetupDone := false
 
+	// Once a test times out, the remaining tests are skipped since the timed out test may still be running
+	timedOut := false
+
 	methodFinder := reflect.TypeOf(suite)
 	tests := []testing.InternalTest{}
 	for index := 0; index < methodFinder.NumMethod(); index++ {
@@ -83,14 +115,19 @@ func RunTests(t *testing.T, suite TestingSuite, request *TestRequest) {
 			fmt.Fprintf(os.Stderr, "invalid regexp for -m: %s\n", err)
 			os.Exit(1)
 		}
-		if !ok {
+		if !ok || !inShard(method.Name, options.shard, options.shards) {
 			continue
 		}
 		if !suiteSetupDone {
+			args, err := discoverArgs(suite, request.Args)
+			if err != nil {
+				panic(err)
+			}
 			if setupTestSuite, ok := suite.(SetupTestSuite); ok {
-				if err := setupTestSuite.SetupTestSuite(input.NewContext("", request.Args)); err != nil {
+				if err := setupTestSuite.SetupTestSuite(input.NewContext("", args)); err != nil {
 					panic(err)
 				}
+				Suite{}.Settle()
 			}
 			defer func() {
 				if tearDownTestSuite, ok := suite.(TearDownTestSuite); ok {
@@ -104,12 +141,20 @@ func RunTests(t *testing.T, suite TestingSuite, request *TestRequest) {
 		test := testing.InternalTest{
 			Name: method.Name,
 			F: func(t *testing.T) {
+				if timedOut {
+					t.Skip("skipped: a previous test in the suite timed out")
+				}
 				defer failTestOnPanic(t)
+				if capture != nil {
+					capture.setTest(t.Name())
+					defer capture.setTest("")
+				}
 
 				if setupTestSuite, ok := suite.(SetupTest); ok {
 					if err := setupTestSuite.SetupTest(); err != nil {
 						panic(err)
 					}
+					Suite{}.Settle()
 				}
 				if beforeTestSuite, ok := suite.(BeforeTest); ok {
 					if err := beforeTestSuite.BeforeTest(method.Name); err != nil {
@@ -128,7 +173,19 @@ func RunTests(t *testing.T, suite TestingSuite, request *TestRequest) {
 						}
 					}
 				}()
-				method.Func.Call([]reflect.Value{reflect.ValueOf(suite), reflect.ValueOf(t)})
+				if options.artifactsDir != "" || options.streamSnapshots {
+					defer func() {
+						if t.Failed() {
+							reportSnapshot(t, capture, options)
+						}
+					}()
+				}
+				defer reportChecks(t)
+				if runTest(t, options.timeout, func() {
+					method.Func.Call([]reflect.Value{reflect.ValueOf(suite), reflect.ValueOf(t)})
+				}) {
+					timedOut = true
+				}
 			},
 		}
 		tests = append(tests, test)
@@ -136,6 +193,73 @@ func RunTests(t *testing.T, suite TestingSuite, request *TestRequest) {
 	runTests(t, tests)
 }
 
+// reportSnapshot captures a snapshot of the test namespace for the given failed test and writes it to the
+// artifacts directory and the snapshot stream as configured
+// Snapshots are streamed to the process's original stdout so they aren't tagged as captured output.
+func reportSnapshot(t *testing.T, capture *outputCapture, options suiteOptions) {
+	s, err := captureSnapshot(t.Name())
+	if err != nil {
+		t.Logf("failed to capture namespace snapshot: %v", err)
+		return
+	}
+	if options.artifactsDir != "" {
+		if path, err := writeSnapshot(options.artifactsDir, s); err != nil {
+			t.Logf("failed to write namespace snapshot: %v", err)
+		} else {
+			t.Logf("namespace snapshot written to %s", path)
+		}
+	}
+	if options.streamSnapshots {
+		out := os.Stdout
+		if capture != nil {
+			out = capture.stdout.original
+		}
+		if err := streamSnapshot(out, s); err != nil {
+			t.Logf("failed to write namespace snapshot: %v", err)
+		}
+	}
+}
+
+// runTest runs the given test function, failing the test if it does not complete within the timeout
+// When the timeout expires, the state of the namespace is captured to show what the test was waiting on. A running
+// test function can't be stopped, so the test is failed and runTest returns, leaving the function running in the
+// background while the test is torn down. Panics raised by the abandoned function, e.g. by using the completed
+// test, are printed rather than crashing the worker. Returns whether the test timed out.
+func runTest(t *testing.T, timeout time.Duration, f func()) bool {
+	if timeout == 0 {
+		f()
+		return false
+	}
+
+	var timedOut int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				if atomic.LoadInt32(&timedOut) == 1 {
+					fmt.Fprintf(os.Stderr, "timed out test %s panicked: %v\n", t.Name(), r)
+				} else {
+					// FailNow may only be called from the test goroutine, so the test is only marked failed
+					t.Errorf("test panicked: %v\n%s", r, debug.Stack())
+				}
+			}
+		}()
+		f()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return false
+	case <-timer.C:
+		atomic.StoreInt32(&timedOut, 1)
+		t.Errorf("test timed out after %s: skipping the remaining tests in the suite\n%s", timeout, captureDiagnostics())
+		return true
+	}
+}
+
 // runTest runs a test
 func runTests(t *testing.T, tests []testing.InternalTest) {
 	for _, test := range tests {
@@ -143,6 +267,17 @@ func runTests(t *testing.T, tests []testing.InternalTest) {
 	}
 }
 
+// inShard returns whether the given test belongs to the given shard
+// Tests are assigned to shards by a stable hash of the test name.
+func inShard(name string, shard, shards int) bool {
+	if shards <= 1 {
+		return true
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(name))
+	return int(hash.Sum32()%uint32(shards)) == shard
+}
+
 // testFilter filters test method names
 func testFilter(name string, cases []string) (bool, error) {
 	if ok, _ := regexp.MatchString("^Test", name); !ok {
@@ -0,0 +1,81 @@
+// Copyright 2021-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestInShard(t *testing.T) {
+	names := make([]string, 100)
+	for i := range names {
+		names[i] = fmt.Sprintf("TestMethod%d", i)
+	}
+
+	tests := []struct {
+		name   string
+		shards int
+	}{
+		{name: "no shards", shards: 0},
+		{name: "one shard", shards: 1},
+		{name: "two shards", shards: 2},
+		{name: "many shards", shards: 7},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			shards := test.shards
+			if shards < 1 {
+				shards = 1
+			}
+			counts := make([]int, shards)
+			for _, name := range names {
+				// Each test is run by exactly one shard
+				matches := 0
+				for shard := 0; shard < shards; shard++ {
+					if inShard(name, shard, test.shards) {
+						counts[shard]++
+						matches++
+					}
+				}
+				assert.Equal(t, 1, matches, name)
+			}
+
+			// Tests are spread across all the shards
+			for shard, count := range counts {
+				assert.True(t, count > 0, "shard %d has no tests", shard)
+			}
+		})
+	}
+}
+
+func TestInShardStable(t *testing.T) {
+	// Tests must be assigned to the same shards by every worker and every release
+	tests := []struct {
+		name   string
+		shards int
+		shard  int
+	}{
+		{name: "TestMap", shards: 7, shard: 1},
+		{name: "TestList", shards: 7, shard: 3},
+		{name: "TestLock", shards: 7, shard: 6},
+		{name: "TestMap", shards: 2, shard: 1},
+		{name: "TestLock", shards: 2, shard: 0},
+	}
+	for _, test := range tests {
+		assert.True(t, inShard(test.name, test.shard, test.shards), "%s in shard %d of %d", test.name, test.shard, test.shards)
+	}
+}
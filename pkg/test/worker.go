@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/onosproject/helmit/pkg/helm"
+	jobs "github.com/onosproject/helmit/pkg/job"
 	"github.com/onosproject/helmit/pkg/registry"
 	"google.golang.org/grpc"
 	"net"
@@ -40,9 +41,10 @@ type Worker struct {
 // Run runs a benchmark
 func (w *Worker) Run() error {
 	err := helm.SetContext(&helm.Context{
-		WorkDir:    w.config.Context,
-		Values:     w.config.Values,
-		ValueFiles: w.config.ValueFiles,
+		WorkDir:      w.config.Context,
+		Values:       w.config.Values,
+		StringValues: w.config.StringValues,
+		ValueFiles:   w.config.ValueFiles,
 	})
 	if err != nil {
 		return err
@@ -54,6 +56,9 @@ func (w *Worker) Run() error {
 	}
 	server := grpc.NewServer()
 	RegisterWorkerServiceServer(server, w)
+	if err := jobs.SetServerReady(); err != nil {
+		return err
+	}
 	return server.Serve(lis)
 }
 
@@ -70,11 +75,68 @@ func (w *Worker) runTests(request *TestRequest) {
 		os.Exit(1)
 	}
 
+	if err := parseFlags(request.Suite, test, w.config.Flags); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if w.config.RequireClean {
+		if err := requireEmptyNamespace(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	// Output is captured before the tests are started, since the testing package binds its output to stdout
+	// when the tests are started
+	var capture *outputCapture
+	if w.config.CaptureOutput {
+		var err error
+		if capture, err = captureOutput(); err != nil {
+			fmt.Println(fmt.Errorf("failed to capture output: %v", err))
+			os.Exit(1)
+		}
+	}
+
 	tests := []testing.InternalTest{
 		{
 			Name: request.Suite,
 			F: func(t *testing.T) {
-				RunTests(t, test, request)
+				shard, shards := getTestShard()
+				verifyTeardown := w.config.VerifyTeardown != "" && !w.config.Config.NoTeardown
+				var resources []string
+				if verifyTeardown {
+					var err error
+					if resources, err = listResources(); err != nil {
+						t.Fatal(err)
+					}
+				}
+				runSuite(t, test, request, suiteOptions{
+					timeout:         w.config.TestTimeout,
+					shard:           shard,
+					shards:          shards,
+					artifactsDir:    w.config.ArtifactsDir,
+					streamSnapshots: w.config.SnapshotFile != "",
+					settle:          w.config.Settle,
+					capture:         capture,
+				})
+				if !w.config.Config.NoTeardown {
+					if err := helm.TearDownSharedReleases(); err != nil {
+						t.Error(err)
+					}
+				}
+				if verifyTeardown {
+					if err := verifyTeardownResources(resources); err != nil {
+						if w.config.VerifyTeardown == VerifyTeardownFail {
+							t.Error(err)
+						} else {
+							t.Logf("Warning: %v", err)
+						}
+					}
+				}
+				if capture != nil {
+					capture.stop()
+				}
 			},
 		},
 	}
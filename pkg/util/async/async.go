@@ -148,6 +148,24 @@ func ExecuteOrderedAsync(n int, f func(i int) (interface{}, error)) ([]interface
 	return results, nil
 }
 
+// ExecuteOrderedAsyncLimit executes the given function f n times with at most limit calls running concurrently,
+// populating the given results slice with the results of each function call.
+// Each call is done in a separate goroutine, and calls beyond the limit wait for an earlier call to complete
+// before they're started. If limit is less than 1, all calls are run concurrently as with ExecuteOrderedAsync.
+func ExecuteOrderedAsyncLimit(n int, limit int, f func(i int) (interface{}, error)) ([]interface{}, error) {
+	if limit < 1 || limit >= n {
+		return ExecuteOrderedAsync(n, f)
+	}
+	sem := make(chan struct{}, limit)
+	return ExecuteOrderedAsync(n, func(i int) (interface{}, error) {
+		sem <- struct{}{}
+		defer func() {
+			<-sem
+		}()
+		return f(i)
+	})
+}
+
 type asyncResult struct {
 	i      int
 	result interface{}
@@ -16,7 +16,9 @@ package async
 
 import (
 	"github.com/stretchr/testify/assert"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestRunAsync(t *testing.T) {
@@ -62,3 +64,33 @@ func TestExecuteOrderedAsync(t *testing.T) {
 	assert.Equal(t, "two", results[1].(string))
 	assert.Equal(t, "three", results[2].(string))
 }
+
+func TestExecuteOrderedAsyncLimit(t *testing.T) {
+	values := []string{
+		"one",
+		"two",
+		"three",
+		"four",
+	}
+	mu := &sync.Mutex{}
+	running, maxRunning := 0, 0
+	results, err := ExecuteOrderedAsyncLimit(len(values), 2, func(i int) (interface{}, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return values[i], nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, maxRunning)
+	assert.Equal(t, "one", results[0].(string))
+	assert.Equal(t, "two", results[1].(string))
+	assert.Equal(t, "three", results[2].(string))
+	assert.Equal(t, "four", results[3].(string))
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"github.com/fatih/color"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -30,6 +31,35 @@ var (
 
 const verboseEnv = "VERBOSE_LOGGING"
 
+// progressLogInterval is the minimum interval at which progress lines are printed when not writing to a terminal
+const progressLogInterval = 10 * time.Second
+
+var (
+	// progressShown indicates whether a progress line is displayed on the current line of the terminal
+	progressShown bool
+	// progressPrinted is the time at which a progress line was last printed when not writing to a terminal
+	progressPrinted time.Time
+	progressMu      sync.Mutex
+)
+
+// noColorEnv is the conventional environment variable for disabling ANSI color output
+const noColorEnv = "NO_COLOR"
+
+func init() {
+	if os.Getenv(noColorEnv) != "" {
+		color.NoColor = true
+	}
+}
+
+// SetNoColor disables ANSI color output
+// The setting is propagated to child processes through the environment.
+func SetNoColor(noColor bool) {
+	if noColor {
+		color.NoColor = true
+		_ = os.Setenv(noColorEnv, "true")
+	}
+}
+
 // GetVerbose returns whether verbose logging is enabled
 func GetVerbose() bool {
 	verbose := os.Getenv(verboseEnv)
@@ -64,6 +94,7 @@ type Step struct {
 // Log logs a progress message
 func (s *Step) Log(message string) {
 	if s.verbose {
+		ClearProgress()
 		fmt.Fprintf(writer, "  %s %s %s\n", time.Now().Format(time.RFC3339), s.test, message)
 	}
 }
@@ -71,22 +102,26 @@ func (s *Step) Log(message string) {
 // Logf logs a progress message
 func (s *Step) Logf(message string, args ...interface{}) {
 	if s.verbose {
+		ClearProgress()
 		fmt.Fprintf(writer, "  %s %s %s\n", time.Now().Format(time.RFC3339), s.test, fmt.Sprintf(message, args...))
 	}
 }
 
 // Start starts the step
 func (s *Step) Start() {
+	ClearProgress()
 	fmt.Fprintln(writer, color.CyanString(fmt.Sprintf("%s %s %s %s", start, time.Now().Format(time.RFC3339), s.test, s.name)))
 }
 
 // Complete completes the step
 func (s *Step) Complete() {
+	ClearProgress()
 	fmt.Fprintln(writer, color.GreenString(fmt.Sprintf("%s %s %s %s", success, time.Now().Format(time.RFC3339), s.test, s.name)))
 }
 
 // Fail fails the step with the given error
 func (s *Step) Fail(err error) {
+	ClearProgress()
 	fmt.Fprintln(writer, color.RedString(fmt.Sprintf("%s %s %s %s", failure, time.Now().Format(time.RFC3339), s.test, s.name)))
 }
 
@@ -95,6 +130,7 @@ func Print(line string) {
 	if line == "" {
 		return
 	}
+	ClearProgress()
 	if len(line) >= len(start) && line[:len(start)] == start {
 		fmt.Fprintln(writer, color.CyanString(line))
 	} else if len(line) >= len(success) && line[:len(success)] == success {
@@ -105,3 +141,37 @@ func Print(line string) {
 		fmt.Fprintln(writer, line)
 	}
 }
+
+// PrintProgress prints the given progress line
+// When writing to a terminal, each progress line replaces the previous one in place, so a running task displays a
+// single live status line. The line is cleared before any other output is printed. Otherwise, progress lines are
+// printed at most once every progressLogInterval to avoid flooding the output.
+func PrintProgress(line string) {
+	progressMu.Lock()
+	defer progressMu.Unlock()
+	if !isTerminal() {
+		if time.Since(progressPrinted) >= progressLogInterval {
+			fmt.Fprintln(writer, line)
+			progressPrinted = time.Now()
+		}
+		return
+	}
+	fmt.Fprintf(writer, "\r\033[K%s", line)
+	progressShown = true
+}
+
+// ClearProgress clears the progress line displayed on the terminal, if any
+func ClearProgress() {
+	progressMu.Lock()
+	defer progressMu.Unlock()
+	if progressShown {
+		fmt.Fprint(writer, "\r\033[K")
+		progressShown = false
+	}
+}
+
+// isTerminal returns whether output is written to a terminal
+func isTerminal() bool {
+	info, err := writer.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
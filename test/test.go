@@ -33,23 +33,23 @@ type ChartTestSuite struct {
 
 // TestLocalInstall tests a local chart installation
 func (s *ChartTestSuite) TestLocalInstall(t *testing.T) {
-	atomix := helm.Chart("kubernetes-controller").
+	atomixChart := helm.Chart("kubernetes-controller")
+	atomix := atomixChart.
 		Release("atomix-controller").
 		Set("scope", "Namespace")
-	err := atomix.Install(true)
-	assert.NoError(t, err)
 
-	raft := helm.Chart("raft-storage-controller").
+	raftChart := helm.Chart("raft-storage-controller")
+	raft := raftChart.
 		Release("raft-storage-controller").
 		Set("scope", "Namespace")
 
-	err = raft.Install(true)
-	assert.NoError(t, err)
-
-	topo := helm.Chart("onos-topo").
+	topoChart := helm.Chart("onos-topo").
+		DependsOn(atomixChart)
+	topo := topoChart.
 		Release("onos-topo").
 		Set("store.controller", "atomix-controller-kubernetes-controller:5679")
-	err = topo.Install(true)
+
+	err := helm.InstallGraph(raftChart, topoChart)
 	assert.NoError(t, err)
 
 	client := kubernetes.NewForReleaseOrDie(topo)